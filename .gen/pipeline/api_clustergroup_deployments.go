@@ -56,7 +56,7 @@ func (a *ClustergroupDeploymentsApiService) ApiV1OrgsOrgidClustergroupsClusterGr
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/deployments/{deploymentName}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/deployments/{deploymentName}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"deploymentName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", deploymentName)), -1)
@@ -168,7 +168,7 @@ func (a *ClustergroupDeploymentsApiService) ApiV1OrgsOrgidClustergroupsClusterGr
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/deployments/{deploymentName}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/deployments/{deploymentName}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"deploymentName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", deploymentName)), -1)
@@ -268,7 +268,7 @@ func (a *ClustergroupDeploymentsApiService) ApiV1OrgsOrgidClustergroupsClusterGr
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/deployments/{deploymentName}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/deployments/{deploymentName}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"deploymentName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", deploymentName)), -1)
@@ -379,7 +379,7 @@ func (a *ClustergroupDeploymentsApiService) ApiV1OrgsOrgidClustergroupsClusterGr
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/deployments/{deploymentName}/sync"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/deployments/{deploymentName}/sync"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"deploymentName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", deploymentName)), -1)
@@ -487,7 +487,7 @@ func (a *ClustergroupDeploymentsApiService) ApiV1OrgsOrgidClustergroupsClusterGr
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/deployments"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/deployments"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 
@@ -585,7 +585,7 @@ func (a *ClustergroupDeploymentsApiService) ApiV1OrgsOrgidClustergroupsClusterGr
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/deployments"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/deployments"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 
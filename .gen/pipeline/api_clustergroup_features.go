@@ -48,7 +48,7 @@ func (a *ClustergroupFeaturesApiService) ApiV1OrgsOrgidClustergroupsClusterGroup
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/features/{featureName}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/features/{featureName}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"featureName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", featureName)), -1)
@@ -147,7 +147,7 @@ func (a *ClustergroupFeaturesApiService) ApiV1OrgsOrgidClustergroupsClusterGroup
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/features/{featureName}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/features/{featureName}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"featureName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", featureName)), -1)
@@ -247,7 +247,7 @@ func (a *ClustergroupFeaturesApiService) ApiV1OrgsOrgidClustergroupsClusterGroup
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/features/{featureName}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/features/{featureName}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"featureName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", featureName)), -1)
@@ -349,7 +349,7 @@ func (a *ClustergroupFeaturesApiService) ApiV1OrgsOrgidClustergroupsClusterGroup
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/features/{featureName}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/features/{featureName}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"featureName"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", featureName)), -1)
@@ -449,7 +449,7 @@ func (a *ClustergroupFeaturesApiService) ApiV1OrgsOrgidClustergroupsClusterGroup
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}/features"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}/features"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 
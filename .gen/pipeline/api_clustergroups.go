@@ -47,7 +47,7 @@ func (a *ClustergroupsApiService) ApiV1OrgsOrgidClustergroupsClusterGroupIdDelet
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 
@@ -144,7 +144,7 @@ func (a *ClustergroupsApiService) ApiV1OrgsOrgidClustergroupsClusterGroupIdGet(c
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 
@@ -242,7 +242,7 @@ func (a *ClustergroupsApiService) ApiV1OrgsOrgidClustergroupsClusterGroupIdPut(c
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups/{clusterGroupId}"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups/{clusterGroupId}"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 	localVarPath = strings.Replace(localVarPath, "{"+"clusterGroupId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", clusterGroupId)), -1)
 
@@ -340,7 +340,7 @@ func (a *ClustergroupsApiService) ApiV1OrgsOrgidClustergroupsGet(ctx _context.Co
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -436,7 +436,7 @@ func (a *ClustergroupsApiService) ApiV1OrgsOrgidClustergroupsPost(ctx _context.C
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgid}/clustergroups"
+	localVarPath := a.client.cfg.BasePath + "/api/v1/orgs/{orgId}/clustergroups"
 	localVarPath = strings.Replace(localVarPath, "{"+"orgId"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", orgId)), -1)
 
 	localVarHeaderParams := make(map[string]string)
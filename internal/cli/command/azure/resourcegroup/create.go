@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcegroup
+
+import (
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+type createOptions struct {
+	secretID string
+	location string
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai azure resourcegroup create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [NAME]",
+		Aliases: []string{"c", "new"},
+		Short:   "Create an Azure resource group",
+		Example: `
+		$ banzai azure resourcegroup create myresourcegroup --secret-id 0123456789abcdef --location westeurope`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			return runCreate(banzaiCli, options, name)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.secretID, "secret-id", "", "ID of the Azure secret to create the resource group with")
+	flags.StringVarP(&options.location, "location", "l", "", "Location (e.g. westeurope) of the resource group")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, name string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if name == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("NAME argument must be specified")
+		}
+
+		if err := survey.AskOne(&survey.Input{Message: "Resource group name:"}, &name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get resource group name")
+		}
+	}
+
+	secretID, err := getSecretID(banzaiCli, orgID, options.secretID)
+	if err != nil {
+		return err
+	}
+
+	location := options.location
+	if location == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("--location must be specified")
+		}
+
+		if location, err = input.AskLocation(banzaiCli, input.CloudProviderAzure); err != nil {
+			return err
+		}
+	} else if err := input.IsLocationValid(banzaiCli, input.CloudProviderAzure, location); err != nil {
+		return err
+	}
+
+	created, err := input.CreateResourceGroup(banzaiCli, orgID, secretID, name, location)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("resource group %q created in %s", created, location)
+
+	return nil
+}
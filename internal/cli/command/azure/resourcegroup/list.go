@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcegroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type listOptions struct {
+	secretID string
+}
+
+type resourceGroupRow struct {
+	Name string
+}
+
+// NewListCommand creates a new cobra.Command for `banzai azure resourcegroup list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List Azure resource groups",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.secretID, "secret-id", "", "ID of the Azure secret to access the resource groups with")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	secretID, err := getSecretID(banzaiCli, orgID, options.secretID)
+	if err != nil {
+		return err
+	}
+
+	resourceGroups, _, err := banzaiCli.Client().InfoApi.GetResourceGroups(context.Background(), orgID, secretID)
+	if err != nil {
+		cli.LogAPIError("list resource groups", err, secretID)
+		return errors.WrapIf(utils.ConvertError(err), "could not list resource groups")
+	}
+
+	rows := make([]resourceGroupRow, len(resourceGroups))
+	for i, name := range resourceGroups {
+		rows[i] = resourceGroupRow{Name: name}
+	}
+
+	format.ResourceGroupsWrite(banzaiCli, rows)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcegroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// getSecretID returns the given Azure secret ID after checking its type, or asks for one in interactive mode.
+func getSecretID(banzaiCli cli.Cli, orgID int32, secretID string) (string, error) {
+	if secretID == "" {
+		if !banzaiCli.Interactive() {
+			return "", errors.New("no secret is selected; use the --secret-id option")
+		}
+
+		return input.AskSecret(banzaiCli, orgID, input.CloudProviderAzure)
+	}
+
+	secret, _, err := banzaiCli.Client().SecretsApi.GetSecret(context.Background(), orgID, secretID)
+	if err != nil {
+		cli.LogAPIError("get secret", err, secretID)
+		return "", errors.WrapIf(utils.ConvertError(err), "could not get secret")
+	}
+
+	if secret.Type != input.CloudProviderAzure {
+		return "", errors.Errorf("mismatching secret type '%s' for cloud '%s'", secret.Type, input.CloudProviderAzure)
+	}
+
+	return secretID, nil
+}
@@ -0,0 +1,140 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	objectstore "github.com/banzaicloud/banzai-cli/internal/cli/command/bucket"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// validateCloud validates the given cloud provider (if any).
+// Backup buckets have no location, so unlike the validation of the bucket commands it doesn't require one for Oracle.
+func validateCloud(cloud string) error {
+	if cloud == "" {
+		return nil
+	}
+
+	return input.IsCloudProviderSupported(cloud)
+}
+
+// getBackupBuckets gets the backup buckets of the organization, optionally filtered by cloud provider
+func getBackupBuckets(banzaiCli cli.Cli, orgID int32, cloud string) ([]pipeline.BackupBucketResponse, error) {
+	backupBuckets, _, err := banzaiCli.Client().ArkBucketsApi.ListBackupBuckets(context.Background(), orgID)
+	if err != nil {
+		return nil, errors.WrapIf(utils.ConvertError(err), "could not list backup buckets")
+	}
+
+	buckets := make([]pipeline.BackupBucketResponse, 0, len(backupBuckets))
+	for _, bucket := range backupBuckets {
+		if cloud != "" && cloud != bucket.Cloud {
+			continue
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, nil
+}
+
+// getBackupBucket gets a backup bucket either by its numerical ID or by its name.
+// If ref is empty, the user is asked to select one in interactive mode.
+func getBackupBucket(banzaiCli cli.Cli, orgID int32, ref, cloud string) (pipeline.BackupBucketResponse, error) {
+	buckets, err := getBackupBuckets(banzaiCli, orgID, cloud)
+	if err != nil {
+		return pipeline.BackupBucketResponse{}, err
+	}
+
+	if len(buckets) == 0 {
+		return pipeline.BackupBucketResponse{}, errors.New("there are no backup buckets")
+	}
+
+	if ref == "" {
+		if !banzaiCli.Interactive() {
+			return pipeline.BackupBucketResponse{}, errors.New("NAME or ID argument must be specified")
+		}
+
+		bucketSlice := make([]string, len(buckets))
+		bucketNames := make(map[string]pipeline.BackupBucketResponse, len(buckets))
+		for i, bucket := range buckets {
+			bucketSlice[i] = convertBackupBucket(bucket).GetNameForSelection()
+			bucketNames[bucketSlice[i]] = bucket
+		}
+
+		var selectedName string
+		if err := survey.AskOne(&survey.Select{Message: "Backup bucket:", Options: bucketSlice}, &selectedName, survey.WithValidator(survey.Required)); err != nil {
+			return pipeline.BackupBucketResponse{}, errors.WrapIf(err, "failed to select backup bucket")
+		}
+
+		return bucketNames[selectedName], nil
+	}
+
+	if id, err := strconv.ParseInt(ref, 10, 32); err == nil {
+		for _, bucket := range buckets {
+			if bucket.Id == int32(id) {
+				return bucket, nil
+			}
+		}
+	}
+
+	var matches []pipeline.BackupBucketResponse
+	for _, bucket := range buckets {
+		if bucket.Name == ref {
+			matches = append(matches, bucket)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return pipeline.BackupBucketResponse{}, errors.Errorf("no such backup bucket: %s", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return pipeline.BackupBucketResponse{}, errors.Errorf("there are backup buckets named %q on several clouds; specify the cloud with --cloud", ref)
+	}
+}
+
+// convertBackupBucket converts a backup bucket to the form used for displaying object store buckets.
+// Backup buckets have no location, and their status message tells whether they are in use.
+func convertBackupBucket(backupBucket pipeline.BackupBucketResponse) objectstore.Bucket {
+	bucket := objectstore.Bucket{
+		Name:    backupBucket.Name,
+		Managed: true,
+		Cloud:   backupBucket.Cloud,
+		Status:  backupBucket.Status,
+	}
+
+	if backupBucket.InUse {
+		bucket.StatusMessage = "in use"
+	}
+
+	return bucket
+}
+
+func convertBackupBuckets(backupBuckets []pipeline.BackupBucketResponse) []objectstore.Bucket {
+	buckets := make([]objectstore.Bucket, len(backupBuckets))
+	for i, b := range backupBuckets {
+		buckets[i] = convertBackupBucket(b)
+	}
+
+	return buckets
+}
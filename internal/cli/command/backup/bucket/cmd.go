@@ -0,0 +1,40 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewBucketCommand returns a cobra command for `backup bucket` subcommands.
+func NewBucketCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bucket",
+		Aliases: []string{"buckets", "b"},
+		Short:   "Manage backup buckets",
+	}
+
+	cmd.AddCommand(
+		NewCreateCommand(banzaiCli),
+		NewDeleteCommand(banzaiCli),
+		NewGetCommand(banzaiCli),
+		NewListCommand(banzaiCli),
+		NewSyncCommand(banzaiCli),
+	)
+
+	return cmd
+}
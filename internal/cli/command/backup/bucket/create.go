@@ -0,0 +1,165 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	objectstore "github.com/banzaicloud/banzai-cli/internal/cli/command/bucket"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type createOptions struct {
+	name     string
+	cloud    string
+	secretID string
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai backup bucket create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	o := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create NAME [[--cloud=]CLOUD] [[--secret-id=]SECRET_ID]",
+		Short:   "Create backup bucket",
+		Long:    "Register an existing object storage bucket to store cluster backups in",
+		Args:    cobra.MaximumNArgs(3),
+		Aliases: []string{"c"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			if len(args) > 0 {
+				o.name = args[0]
+			}
+			if len(args) > 1 {
+				o.cloud = args[1]
+			}
+			if len(args) > 2 {
+				o.secretID = args[2]
+			}
+
+			if !banzaiCli.Interactive() {
+				if o.name == "" {
+					return errors.New("NAME must be specified")
+				}
+				if o.cloud == "" {
+					return errors.New("CLOUD argument or --cloud flag must be specified")
+				}
+				if o.secretID == "" {
+					return errors.New("SECRET_ID argument or --secret-id flag must be specified")
+				}
+			}
+
+			err := validateCloud(o.cloud)
+			if err != nil {
+				return err
+			}
+
+			return runCreate(banzaiCli, o)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&o.cloud, "cloud", "c", "", "Cloud provider of the bucket")
+	flags.StringVarP(&o.secretID, "secret-id", "s", "", "Secret ID of the secret used to access the bucket")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, o createOptions) error {
+	var err error
+
+	orgID := input.GetOrganization(banzaiCli)
+
+	if o.cloud == "" {
+		// Select cloud
+		o.cloud, err = input.AskCloud()
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.name == "" {
+		// Offer the buckets managed by Pipeline first
+		found, bucket, err := objectstore.GetManagedBucket(banzaiCli, orgID, "", o.cloud, "", "")
+		if err != nil {
+			return err
+		}
+
+		if found {
+			o.name = bucket.Name
+		} else {
+			err = survey.AskOne(&survey.Input{Message: "Bucket name:"}, &o.name, survey.WithValidator(input.BucketNameValidator(o.cloud)))
+			if err != nil {
+				return errors.WrapIf(err, "failed to select name")
+			}
+		}
+	} else {
+		err = input.ValidateBucketName(o.cloud, o.name)
+		if err != nil {
+			return errors.WrapIf(err, "failed to validate bucket name")
+		}
+	}
+
+	if o.secretID == "" {
+		// Ask for secret
+		o.secretID, err = input.AskSecret(banzaiCli, orgID, o.cloud)
+		if err != nil {
+			return err
+		}
+	}
+
+	secret, _, err := banzaiCli.Client().SecretsApi.GetSecret(context.Background(), orgID, o.secretID)
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(errors.WithStack(err)), "could not get secret")
+	}
+
+	if secret.Type != o.cloud {
+		return errors.Errorf("mismatching secret type '%s' for cloud '%s'", secret.Type, o.cloud)
+	}
+
+	request := pipeline.CreateBackupBucketRequest{
+		Cloud:      o.cloud,
+		BucketName: o.name,
+		SecretId:   o.secretID,
+	}
+
+	response, _, err := banzaiCli.Client().ArkBucketsApi.CreateBackupBucket(context.Background(), orgID, request)
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(err), "could not create backup bucket")
+	}
+
+	log.Infof("backup bucket '%s' successfully created", response.Name)
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.DetailedBucketWrite(banzaiCli, response, response.Cloud)
+		return nil
+	}
+
+	format.DetailedBucketWrite(banzaiCli, convertBackupBucket(response), response.Cloud)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type deleteOptions struct {
+	ref   string
+	cloud string
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai backup bucket delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	o := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAME|ID]",
+		Short:   "Delete backup bucket",
+		Long:    "Delete a backup bucket from Pipeline. The bucket itself and the backups in it are kept at the cloud provider. Backup buckets in use by a cluster can't be deleted.",
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"d", "del"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			if len(args) > 0 {
+				o.ref = args[0]
+			}
+
+			err := validateCloud(o.cloud)
+			if err != nil {
+				return err
+			}
+
+			return runDelete(banzaiCli, o)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&o.cloud, "cloud", "", "", "Cloud provider of the backup bucket")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, o deleteOptions) error {
+	orgID := input.GetOrganization(banzaiCli)
+
+	bucket, err := getBackupBucket(banzaiCli, orgID, o.ref, o.cloud)
+	if err != nil {
+		return err
+	}
+
+	if bucket.InUse {
+		return errors.Errorf("backup bucket '%s' is in use", bucket.Name)
+	}
+
+	if banzaiCli.Interactive() {
+		format.DetailedBucketWrite(banzaiCli, convertBackupBucket(bucket), bucket.Cloud)
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the backup bucket?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	_, _, err = banzaiCli.Client().ArkBucketsApi.DeleteBackupBucket(context.Background(), orgID, bucket.Id)
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(err), "could not delete backup bucket")
+	}
+
+	log.Infof("backup bucket '%s' successfully deleted", bucket.Name)
+
+	return nil
+}
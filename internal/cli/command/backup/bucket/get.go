@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type getOptions struct {
+	ref   string
+	cloud string
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai backup bucket get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	o := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get [NAME|ID]",
+		Short:   "Get backup bucket",
+		Long:    "Get the details of a backup bucket, including the cluster using it",
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"g"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			if len(args) > 0 {
+				o.ref = args[0]
+			}
+
+			err := validateCloud(o.cloud)
+			if err != nil {
+				return err
+			}
+
+			return runGet(banzaiCli, o)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&o.cloud, "cloud", "", "", "Cloud provider of the backup bucket")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, o getOptions) error {
+	orgID := input.GetOrganization(banzaiCli)
+
+	backupBucket, err := getBackupBucket(banzaiCli, orgID, o.ref, o.cloud)
+	if err != nil {
+		return err
+	}
+
+	details, _, err := banzaiCli.Client().ArkBucketsApi.GetBackupBucket(context.Background(), orgID, backupBucket.Id)
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(err), "could not get backup bucket")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.DetailedBucketWrite(banzaiCli, details, details.Cloud)
+		return nil
+	}
+
+	bucket := convertBackupBucket(pipeline.BackupBucketResponse{
+		Name:   details.Name,
+		Cloud:  details.Cloud,
+		Status: details.Status,
+		InUse:  details.InUse,
+	})
+	if details.ClusterId != 0 {
+		bucket.StatusMessage = fmt.Sprintf("in use by cluster %d (%s/%s)", details.ClusterId, details.ClusterCloud, details.ClusterDistribution)
+	}
+
+	format.DetailedBucketWrite(banzaiCli, bucket, bucket.Cloud)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type listOptions struct {
+	cloud string
+}
+
+// NewListCommand creates a new cobra.Command for `banzai backup bucket list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	o := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List backup buckets",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"l", "ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			err := validateCloud(o.cloud)
+			if err != nil {
+				return err
+			}
+
+			return runList(banzaiCli, o)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&o.cloud, "cloud", "", "", "Filter backup buckets by cloud provider where they reside")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, o listOptions) error {
+	buckets, err := getBackupBuckets(banzaiCli, input.GetOrganization(banzaiCli), o.cloud)
+	if err != nil {
+		return err
+	}
+
+	if len(buckets) < 1 {
+		if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+			log.Info("No backup buckets were found")
+		}
+		return nil
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.DetailedBucketWrite(banzaiCli, buckets, o.cloud)
+		return nil
+	}
+
+	format.DetailedBucketWrite(banzaiCli, convertBackupBuckets(buckets), o.cloud)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bucket
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewSyncCommand creates a new cobra.Command for `banzai backup bucket sync`.
+func NewSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Synchronize backup buckets",
+		Long:  "Synchronize the backups stored in the backup buckets of the organization with Pipeline",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runSync(banzaiCli)
+		},
+	}
+
+	return cmd
+}
+
+func runSync(banzaiCli cli.Cli) error {
+	_, err := banzaiCli.Client().ArkBucketsApi.SyncBackupBucket(context.Background(), input.GetOrganization(banzaiCli))
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(err), "could not synchronize backup buckets")
+	}
+
+	log.Info("backup buckets successfully synchronized")
+
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clusterbackup "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type listOptions struct {
+	allClusters bool
+	maxAge      time.Duration
+	sync        bool
+}
+
+// clusterInventory summarizes the backups of a cluster
+type clusterInventory struct {
+	ClusterId      int32                     `json:"clusterId"`
+	ClusterName    string                    `json:"clusterName"`
+	BackupCount    int                       `json:"backupCount"`
+	LastSuccessful string                    `json:"lastSuccessful,omitempty"`
+	Age            string                    `json:"age,omitempty"`
+	Stale          bool                      `json:"stale"`
+	Backups        []pipeline.BackupResponse `json:"backups"`
+}
+
+// NewListCommand creates a new cobra.Command for `banzai backup list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List backups of the organization",
+		Long: "List the backups of all clusters of the organization. " +
+			"With --all-clusters the backups are grouped by cluster, and clusters without a successful backup newer than --max-age are flagged as stale. " +
+			"The command fails if there is any stale cluster, so it can be used for periodic checks.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.allClusters, "all-clusters", false, "Group backups by cluster and check their age")
+	flags.DurationVar(&options.maxAge, "max-age", 24*time.Hour, "Maximum age of the newest successful backup of a cluster")
+	flags.BoolVar(&options.sync, "sync", false, "Synchronize the backups from the backup buckets first")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	client := banzaiCli.Client()
+	orgID := input.GetOrganization(banzaiCli)
+
+	if options.sync {
+		if _, err := client.ArkBackupsApi.SyncOrgBackups(context.Background(), orgID); err != nil {
+			return errors.WrapIf(utils.ConvertError(err), "could not synchronize backups")
+		}
+	}
+
+	backups, _, err := client.ArkBackupsApi.ListARKBackupsForOrganization(context.Background(), orgID)
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(err), "could not list backups")
+	}
+
+	if !options.allClusters {
+		format.OrganizationBackupsWrite(banzaiCli, backups)
+		return nil
+	}
+
+	clusters, _, err := client.ClustersApi.ListClusters(context.Background(), orgID)
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(err), "could not list clusters")
+	}
+
+	inventory := buildInventory(clusters, backups, options.maxAge, time.Now())
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault && len(inventory) == 0 {
+		log.Info("No clusters were found")
+		return nil
+	}
+
+	format.BackupInventoryWrite(banzaiCli, inventory)
+
+	var stale int
+	for _, cluster := range inventory {
+		if cluster.Stale {
+			stale++
+		}
+	}
+
+	if stale > 0 {
+		return errors.Errorf("%d of %d clusters have no successful backup in the last %s", stale, len(inventory), options.maxAge)
+	}
+
+	return nil
+}
+
+// buildInventory groups the backups by cluster, and checks whether the newest successful backup of each cluster is younger than maxAge.
+// Backups of deleted clusters are listed as well, but those clusters are never stale.
+func buildInventory(clusters []pipeline.GetClusterStatusResponse, backups []pipeline.BackupResponse, maxAge time.Duration, now time.Time) []clusterInventory {
+	inventory := make(map[int32]*clusterInventory, len(clusters))
+	existing := make(map[int32]bool, len(clusters))
+	for _, cluster := range clusters {
+		inventory[cluster.Id] = &clusterInventory{ClusterId: cluster.Id, ClusterName: cluster.Name}
+		existing[cluster.Id] = true
+	}
+
+	lastSuccessful := make(map[int32]time.Time)
+	for _, backup := range backups {
+		cluster, ok := inventory[backup.ClusterId]
+		if !ok {
+			cluster = &clusterInventory{ClusterId: backup.ClusterId}
+			inventory[backup.ClusterId] = cluster
+		}
+
+		cluster.Backups = append(cluster.Backups, backup)
+		cluster.BackupCount++
+
+		if backup.Status != clusterbackup.PhaseCompleted {
+			continue
+		}
+
+		startAt, err := time.Parse(time.RFC3339, backup.StartAt)
+		if err != nil {
+			log.Debugf("invalid start time of backup %d: %v", backup.Id, err)
+			continue
+		}
+
+		if startAt.After(lastSuccessful[backup.ClusterId]) {
+			lastSuccessful[backup.ClusterId] = startAt
+		}
+	}
+
+	result := make([]clusterInventory, 0, len(inventory))
+	for id, cluster := range inventory {
+		if last, ok := lastSuccessful[id]; ok {
+			age := now.Sub(last)
+			cluster.LastSuccessful = last.Format(time.RFC3339)
+			cluster.Age = age.Round(time.Minute).String()
+			cluster.Stale = age > maxAge
+		} else {
+			cluster.Stale = true
+		}
+
+		if _, ok := existing[id]; !ok {
+			cluster.Stale = false
+		}
+
+		result = append(result, *cluster)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ClusterId < result[j].ClusterId })
+
+	return result
+}
@@ -0,0 +1,67 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestBuildInventory(t *testing.T) {
+	now := time.Date(2020, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	clusters := []pipeline.GetClusterStatusResponse{
+		{Id: 1, Name: "fresh"},
+		{Id: 2, Name: "outdated"},
+		{Id: 3, Name: "failing"},
+		{Id: 4, Name: "unprotected"},
+	}
+
+	backups := []pipeline.BackupResponse{
+		{Id: 10, ClusterId: 1, Status: "Completed", StartAt: "2020-03-09T12:00:00Z"},
+		{Id: 11, ClusterId: 1, Status: "Completed", StartAt: "2020-03-10T06:00:00Z"},
+		{Id: 12, ClusterId: 1, Status: "Failed", StartAt: "2020-03-10T11:00:00Z"},
+		{Id: 20, ClusterId: 2, Status: "Completed", StartAt: "2020-03-08T12:00:00Z"},
+		{Id: 30, ClusterId: 3, Status: "PartiallyFailed", StartAt: "2020-03-10T11:00:00Z"},
+		{Id: 50, ClusterId: 5, Status: "Completed", StartAt: "2020-01-01T00:00:00Z"},
+	}
+
+	inventory := buildInventory(clusters, backups, 24*time.Hour, now)
+
+	type summary struct {
+		id             int32
+		count          int
+		lastSuccessful string
+		age            string
+		stale          bool
+	}
+
+	var actual []summary
+	for _, cluster := range inventory {
+		actual = append(actual, summary{cluster.ClusterId, cluster.BackupCount, cluster.LastSuccessful, cluster.Age, cluster.Stale})
+	}
+
+	require.Equal(t, []summary{
+		{1, 3, "2020-03-10T06:00:00Z", "6h0m0s", false},
+		{2, 1, "2020-03-08T12:00:00Z", "48h0m0s", true},
+		{3, 1, "", "", true},
+		{4, 0, "", "", true},
+		{5, 1, "2020-01-01T00:00:00Z", "1668h0m0s", false},
+	}, actual)
+}
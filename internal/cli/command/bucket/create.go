@@ -184,7 +184,7 @@ func runCreate(banzaiCli cli.Cli, o createBucketsOptions) error {
 			}
 
 			// Ask for resource group
-			o.resourceGroup, err = input.AskResourceGroup(banzaiCli, orgID, o.secretID, o.resourceGroup)
+			o.resourceGroup, err = input.AskOrCreateResourceGroup(banzaiCli, orgID, o.secretID, o.location, o.resourceGroup)
 			if err != nil {
 				return errors.WrapIf(err, "failed to select resource group")
 			}
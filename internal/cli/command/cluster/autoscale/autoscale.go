@@ -0,0 +1,159 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscale
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+// scalingInfo is the autoscaling status of a scale target as returned by Pipeline.
+type scalingInfo struct {
+	ScaleTarget   string                        `json:"scaleTarget"`
+	Kind          string                        `json:"kind"`
+	MinReplicas   int32                         `json:"minReplicas"`
+	MaxReplicas   int32                         `json:"maxReplicas"`
+	Cpu           resourceMetricStatus          `json:"cpu"`
+	Memory        resourceMetricStatus          `json:"memory"`
+	CustomMetrics map[string]customMetricStatus `json:"customMetrics"`
+	Status        struct {
+		CurrentReplicas int32  `json:"currentReplicas"`
+		DesiredReplicas int32  `json:"desiredReplicas"`
+		Message         string `json:"message"`
+	} `json:"status"`
+}
+
+type resourceMetricStatus struct {
+	TargetAverageValue      string `json:"targetAverageValue"`
+	TargetAverageValueType  string `json:"targetAverageValueType"`
+	CurrentAverageValue     string `json:"currentAverageValue"`
+	CurrentAverageValueType string `json:"currentAverageValueType"`
+}
+
+type customMetricStatus struct {
+	Type                string `json:"type"`
+	TargetAverageValue  string `json:"targetAverageValue"`
+	CurrentAverageValue string `json:"currentAverageValue"`
+}
+
+type scalingRow struct {
+	ScaleTarget     string
+	Kind            string
+	MinReplicas     int32
+	MaxReplicas     int32
+	CurrentReplicas int32
+	DesiredReplicas int32
+	Message         string
+}
+
+type metricRow struct {
+	ScaleTarget string
+	Metric      string
+	Type        string
+	Target      string
+	Current     string
+}
+
+// writeScalingInfo writes the autoscaling status of a scale target to the output.
+func writeScalingInfo(banzaiCli cli.Cli, raw []map[string]interface{}) error {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.AutoscalingWrite(banzaiCli, raw)
+		return nil
+	}
+
+	content, err := json.Marshal(raw)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal autoscaling info")
+	}
+
+	var infos []scalingInfo
+	if err := json.Unmarshal(content, &infos); err != nil {
+		return errors.WrapIf(err, "failed to unmarshal autoscaling info")
+	}
+
+	scalingRows := make([]scalingRow, len(infos))
+	var metricRows []metricRow
+	for i, info := range infos {
+		scalingRows[i] = scalingRow{
+			ScaleTarget:     info.ScaleTarget,
+			Kind:            info.Kind,
+			MinReplicas:     info.MinReplicas,
+			MaxReplicas:     info.MaxReplicas,
+			CurrentReplicas: info.Status.CurrentReplicas,
+			DesiredReplicas: info.Status.DesiredReplicas,
+			Message:         info.Status.Message,
+		}
+		metricRows = append(metricRows, metricRowsOf(info)...)
+	}
+
+	format.AutoscalingWrite(banzaiCli, scalingRows)
+
+	if len(metricRows) > 0 {
+		fmt.Fprintln(banzaiCli.Out())
+		format.AutoscalingMetricsWrite(banzaiCli, metricRows)
+	}
+
+	return nil
+}
+
+func metricRowsOf(info scalingInfo) []metricRow {
+	var rows []metricRow
+
+	for _, resource := range []struct {
+		name   string
+		status resourceMetricStatus
+	}{
+		{name: "cpu", status: info.Cpu},
+		{name: "memory", status: info.Memory},
+	} {
+		if resource.status.TargetAverageValue == "" {
+			continue
+		}
+
+		rows = append(rows, metricRow{
+			ScaleTarget: info.ScaleTarget,
+			Metric:      resource.name,
+			Type:        resource.status.TargetAverageValueType,
+			Target:      resource.status.TargetAverageValue,
+			Current:     resource.status.CurrentAverageValue,
+		})
+	}
+
+	names := make([]string, 0, len(info.CustomMetrics))
+	for name := range info.CustomMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		metric := info.CustomMetrics[name]
+		rows = append(rows, metricRow{
+			ScaleTarget: info.ScaleTarget,
+			Metric:      name,
+			Type:        metric.Type,
+			Target:      metric.TargetAverageValue,
+			Current:     metric.CurrentAverageValue,
+		})
+	}
+
+	return rows
+}
@@ -0,0 +1,80 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscale
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai cluster autoscale delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete DEPLOYMENT",
+		Aliases: []string{"d", "rm", "unset"},
+		Short:   "Delete autoscaling of a deployment",
+		Long:    "Delete horizontal pod autoscaling of a Kubernetes Deployment or StatefulSet.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDelete(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete autoscaling of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, scaleTarget string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the autoscaling of the deployment?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	_, err := banzaiCli.Client().HpaApi.DeleteDeploymentAutoscaling(context.Background(), orgID, options.ClusterID(), scaleTarget)
+	if err != nil {
+		cli.LogAPIError("delete autoscaling", err, scaleTarget)
+		return errors.WrapIf(utils.ConvertError(err), "failed to delete autoscaling")
+	}
+
+	log.Infof("autoscaling of %q deleted", scaleTarget)
+
+	return nil
+}
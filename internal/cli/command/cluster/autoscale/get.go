@@ -0,0 +1,70 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscale
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type getOptions struct {
+	clustercontext.Context
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai cluster autoscale get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get DEPLOYMENT",
+		Aliases: []string{"g", "show"},
+		Short:   "Get autoscaling status of a deployment",
+		Long:    "Get horizontal pod autoscaling status of a Kubernetes Deployment or StatefulSet.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runGet(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get autoscaling of")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, scaleTarget string) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	raw, _, err := banzaiCli.Client().HpaApi.GetDeploymentAutoscaling(context.Background(), banzaiCli.Context().OrganizationID(), options.ClusterID(), scaleTarget)
+	if err != nil {
+		cli.LogAPIError("get autoscaling", err, scaleTarget)
+		return errors.WrapIff(err, "could not get autoscaling of %q", scaleTarget)
+	}
+
+	if len(raw) == 0 && banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		return errors.Errorf("no autoscaling is set for %q", scaleTarget)
+	}
+
+	return writeScalingInfo(banzaiCli, raw)
+}
@@ -0,0 +1,191 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscale
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+const (
+	targetTypePercentage = "percentage"
+	targetTypeQuantity   = "quantity"
+
+	defaultCustomMetricType = "pod"
+)
+
+type setOptions struct {
+	clustercontext.Context
+
+	file             string
+	minReplicas      int32
+	maxReplicas      int32
+	cpu              string
+	memory           string
+	customMetrics    []string
+	customMetricType string
+}
+
+// NewSetCommand creates a new cobra.Command for `banzai cluster autoscale set`.
+func NewSetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := setOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "set DEPLOYMENT",
+		Aliases: []string{"s", "update"},
+		Short:   "Set autoscaling of a deployment",
+		Long:    "Set horizontal pod autoscaling of a Kubernetes Deployment or StatefulSet. Resource targets ending with % are percentages of the requested resources, others are quantities. The request can be given as a YAML/JSON file as well, in which case the flags override its values.",
+		Example: `
+		$ banzai cluster autoscale set my-app --min 2 --max 10 --cpu 80% --memory 512Mi
+		$ banzai cluster autoscale set my-app --min 1 --max 5 --custom-metric 'sum(rate(http_requests_total[1m]))=100'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runSet(banzaiCli, options, cmd, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Autoscaling descriptor file")
+	flags.Int32Var(&options.minReplicas, "min", 1, "Minimum number of replicas")
+	flags.Int32Var(&options.maxReplicas, "max", 0, "Maximum number of replicas")
+	flags.StringVar(&options.cpu, "cpu", "", "Target average CPU usage, for example 80% or 500m")
+	flags.StringVar(&options.memory, "memory", "", "Target average memory usage, for example 70% or 512Mi")
+	flags.StringSliceVar(&options.customMetrics, "custom-metric", nil, "Target average value of a custom metric in METRIC=VALUE format (can be repeated)")
+	flags.StringVar(&options.customMetricType, "custom-metric-type", defaultCustomMetricType, "Type of the custom metrics given with --custom-metric")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "set autoscaling of")
+
+	return cmd
+}
+
+func runSet(banzaiCli cli.Cli, options setOptions, cmd *cobra.Command, scaleTarget string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var request pipeline.DeploymentScalingRequest
+	if options.file != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.file)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal input")
+		}
+	}
+
+	request.ScaleTarget = scaleTarget
+
+	flags := cmd.Flags()
+	if flags.Changed("min") || request.MinReplicas == 0 {
+		request.MinReplicas = options.minReplicas
+	}
+	if flags.Changed("max") {
+		request.MaxReplicas = options.maxReplicas
+	}
+
+	if options.cpu != "" {
+		request.Cpu = parseResourceTarget(options.cpu)
+	}
+	if options.memory != "" {
+		request.Memory = parseResourceTarget(options.memory)
+	}
+
+	for _, spec := range options.customMetrics {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return errors.Errorf("invalid custom metric %q, expected METRIC=VALUE format", spec)
+		}
+
+		if request.CustomMetrics == nil {
+			request.CustomMetrics = map[string]pipeline.CustomMetric{}
+		}
+		request.CustomMetrics[parts[0]] = pipeline.CustomMetric{
+			Type:               options.customMetricType,
+			TargetAverageValue: parts[1],
+		}
+	}
+
+	if err := validateRequest(request); err != nil {
+		return err
+	}
+
+	log.Debugf("set autoscaling request: %#v", request)
+	_, err := banzaiCli.Client().HpaApi.UpdateDeploymentAutoscaling(context.Background(), orgID, options.ClusterID(), request)
+	if err != nil {
+		cli.LogAPIError("set autoscaling", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to set autoscaling")
+	}
+
+	log.Infof("autoscaling of %q set", scaleTarget)
+
+	// Pipeline creates the autoscaler asynchronously, so its status is only shown if it's already there
+	raw, _, err := banzaiCli.Client().HpaApi.GetDeploymentAutoscaling(context.Background(), orgID, options.ClusterID(), scaleTarget)
+	if err != nil || len(raw) == 0 {
+		log.Debugf("autoscaling status of %q is not available yet: %v", scaleTarget, err)
+		return nil
+	}
+
+	if err := writeScalingInfo(banzaiCli, raw); err != nil {
+		log.Debugf("failed to write autoscaling status: %v", err)
+	}
+
+	return nil
+}
+
+// parseResourceTarget converts a target like 80% or 512Mi to a resource metric target.
+func parseResourceTarget(target string) pipeline.ResourceMetric {
+	if strings.HasSuffix(target, "%") {
+		return pipeline.ResourceMetric{
+			TargetAverageValue:     strings.TrimSuffix(target, "%"),
+			TargetAverageValueType: targetTypePercentage,
+		}
+	}
+
+	return pipeline.ResourceMetric{
+		TargetAverageValue:     target,
+		TargetAverageValueType: targetTypeQuantity,
+	}
+}
+
+func validateRequest(request pipeline.DeploymentScalingRequest) error {
+	if request.MinReplicas < 1 {
+		return errors.New("minimum number of replicas must be at least 1")
+	}
+
+	if request.MaxReplicas < request.MinReplicas {
+		return errors.New("maximum number of replicas must be specified, and be at least the minimum number of replicas")
+	}
+
+	if request.Cpu.TargetAverageValue == "" && request.Memory.TargetAverageValue == "" && len(request.CustomMetrics) == 0 {
+		return errors.New("at least one CPU, memory or custom metric target must be specified")
+	}
+
+	return nil
+}
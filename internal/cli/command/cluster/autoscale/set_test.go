@@ -0,0 +1,41 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestParseResourceTarget(t *testing.T) {
+	tests := []struct {
+		target   string
+		expected pipeline.ResourceMetric
+	}{
+		{target: "80%", expected: pipeline.ResourceMetric{TargetAverageValue: "80", TargetAverageValueType: targetTypePercentage}},
+		{target: "500m", expected: pipeline.ResourceMetric{TargetAverageValue: "500m", TargetAverageValueType: targetTypeQuantity}},
+		{target: "512Mi", expected: pipeline.ResourceMetric{TargetAverageValue: "512Mi", TargetAverageValueType: targetTypeQuantity}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.target, func(t *testing.T) {
+			require.Equal(t, test.expected, parseResourceTarget(test.target))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ark
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// BackupOptions are the options of backups, backup schedules and restores accepted by Pipeline.
+// It replaces BackupOptions of the generated client, which drops false values of the boolean options
+// (letting Pipeline fall back to its defaults) and can't hold a label selector.
+type BackupOptions struct {
+	IncludedNamespaces      []string       `json:"includedNamespaces,omitempty"`
+	ExcludedNamespaces      []string       `json:"excludedNamespaces,omitempty"`
+	IncludedResources       []string       `json:"includedResources,omitempty"`
+	ExcludedResources       []string       `json:"excludedResources,omitempty"`
+	LabelSelector           *LabelSelector `json:"labelSelector,omitempty"`
+	SnapshotVolumes         *bool          `json:"snapshotVolumes,omitempty"`
+	IncludeClusterResources *bool          `json:"includeClusterResources,omitempty"`
+}
+
+// LabelSelector selects the resources to back up by their labels.
+type LabelSelector struct {
+	MatchLabels      map[string]string          `json:"matchLabels,omitempty"`
+	MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+// LabelSelectorRequirement is a label selector requirement, e.g. `app in (web, api)`.
+type LabelSelectorRequirement struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values,omitempty"`
+}
+
+// ChangedBool returns the value of a boolean flag if it was set explicitly, and nil otherwise.
+func ChangedBool(flags *pflag.FlagSet, name string) *bool {
+	if !flags.Changed(name) {
+		return nil
+	}
+
+	value, err := flags.GetBool(name)
+	if err != nil {
+		return nil
+	}
+
+	return &value
+}
+
+// Post sends a request to the given path of the Pipeline API, and decodes the response into response unless it's nil.
+// It is used instead of the generated client where the request contains BackupOptions.
+func Post(banzaiCli cli.Cli, path string, request interface{}, response interface{}) error {
+	config := banzaiCli.Client().GetConfig()
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal request")
+	}
+
+	httpRequest, err := http.NewRequest(http.MethodPost, config.BasePath+path, bytes.NewReader(body))
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("User-Agent", config.UserAgent)
+
+	httpResponse, err := config.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return errors.WrapIf(err, "failed to send request")
+	}
+	defer httpResponse.Body.Close()
+
+	responseBody, _ := ioutil.ReadAll(httpResponse.Body)
+	log.Debugf("response of POST %s: %s", path, responseBody)
+
+	if httpResponse.StatusCode >= http.StatusMultipleChoices {
+		var pipelineError pipeline.CommonError
+		if err := json.Unmarshal(responseBody, &pipelineError); err == nil && pipelineError.Message != "" {
+			return errors.New(pipelineError.Message)
+		}
+
+		return errors.Errorf("unexpected response: %s", httpResponse.Status)
+	}
+
+	if response != nil {
+		if err := json.Unmarshal(responseBody, response); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal response")
+		}
+	}
+
+	return nil
+}
+
+// ClusterPath returns the path of a cluster resource in the Pipeline API.
+func ClusterPath(orgID, clusterID int32, resource string) string {
+	return fmt.Sprintf("/api/v1/orgs/%d/clusters/%d/%s", orgID, clusterID, resource)
+}
@@ -0,0 +1,91 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// Backup phases reported by Pipeline (mirroring the ones of Ark/Velero)
+const (
+	PhaseNew              = "New"
+	PhaseInProgress       = "InProgress"
+	PhaseCompleted        = "Completed"
+	PhasePartiallyFailed  = "PartiallyFailed"
+	PhaseFailed           = "Failed"
+	PhaseFailedValidation = "FailedValidation"
+	PhaseDeleting         = "Deleting"
+)
+
+// IsFinished returns true if a backup with the given status won't change anymore
+func IsFinished(status string) bool {
+	return status != PhaseNew && status != PhaseInProgress && status != ""
+}
+
+// GetBackup gets a backup of a cluster either by its numerical ID or by its name.
+// If ref is empty, the user is asked to select one in interactive mode.
+func GetBackup(banzaiCli cli.Cli, orgID, clusterID int32, ref string) (pipeline.BackupResponse, error) {
+	client := banzaiCli.Client()
+
+	if id, err := strconv.ParseInt(ref, 10, 32); err == nil {
+		backup, _, err := client.ArkBackupsApi.GetARKBackup(context.Background(), orgID, clusterID, int32(id))
+		if err != nil {
+			cli.LogAPIError("get backup", err, id)
+			return backup, errors.WrapIff(err, "could not get backup %d", id)
+		}
+
+		return backup, nil
+	}
+
+	backups, _, err := client.ArkBackupsApi.ListARKBackupsOfACluster(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list backups", err, clusterID)
+		return pipeline.BackupResponse{}, errors.WrapIf(err, "could not list backups")
+	}
+
+	if len(backups) == 0 {
+		return pipeline.BackupResponse{}, errors.New("there are no backups of the cluster")
+	}
+
+	if ref == "" {
+		if !banzaiCli.Interactive() {
+			return pipeline.BackupResponse{}, errors.New("no backup is selected; specify the name or ID of the backup")
+		}
+
+		names := make([]string, len(backups))
+		for i, backup := range backups {
+			names[i] = backup.Name
+		}
+
+		if err := survey.AskOne(&survey.Select{Message: "Backup:", Options: names}, &ref, survey.WithValidator(survey.Required)); err != nil {
+			return pipeline.BackupResponse{}, errors.WrapIf(err, "failed to select a backup")
+		}
+	}
+
+	for _, backup := range backups {
+		if backup.Name == ref {
+			return backup, nil
+		}
+	}
+
+	return pipeline.BackupResponse{}, errors.Errorf("could not find backup named %q", ref)
+}
@@ -0,0 +1,47 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup/schedule"
+)
+
+// NewBackupCommand returns a cobra command for `backup` subcommands.
+func NewBackupCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "backup",
+		Aliases: []string{"backups", "bk"},
+		Short:   "Manage cluster backups",
+	}
+
+	cmd.AddCommand(
+		NewCreateCommand(banzaiCli),
+		NewDeleteCommand(banzaiCli),
+		NewDisableCommand(banzaiCli),
+		NewDownloadCommand(banzaiCli),
+		NewEnableCommand(banzaiCli),
+		NewGetCommand(banzaiCli),
+		NewListCommand(banzaiCli),
+		NewLogsCommand(banzaiCli),
+		NewStatusCommand(banzaiCli),
+		NewSyncCommand(banzaiCli),
+		schedule.NewScheduleCommand(banzaiCli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup/ark"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+// createBackupRequest is the backup create request accepted by Pipeline.
+// It differs from CreateBackupRequest of the generated client only in its options.
+type createBackupRequest struct {
+	Name    string            `json:"name"`
+	Ttl     string            `json:"ttl"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Options ark.BackupOptions `json:"options"`
+}
+
+type createOptions struct {
+	clustercontext.Context
+
+	name                    string
+	ttl                     string
+	includedNamespaces      []string
+	excludedNamespaces      []string
+	includedResources       []string
+	excludedResources       []string
+	snapshotVolumes         *bool
+	includeClusterResources *bool
+
+	wait     bool
+	interval int
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai cluster backup create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [NAME]",
+		Aliases: []string{"c"},
+		Short:   "Create a backup of a cluster",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if len(args) > 0 {
+				options.name = args[0]
+			}
+
+			options.snapshotVolumes = ark.ChangedBool(cmd.Flags(), "snapshot-volumes")
+			options.includeClusterResources = ark.ChangedBool(cmd.Flags(), "include-cluster-resources")
+
+			return runCreate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.ttl, "ttl", "720h0m0s", "How long the backup is kept")
+	flags.StringSliceVar(&options.includedNamespaces, "include-namespace", []string{"*"}, "Namespaces to include in the backup")
+	flags.StringSliceVar(&options.excludedNamespaces, "exclude-namespace", nil, "Namespaces to exclude from the backup")
+	flags.StringSliceVar(&options.includedResources, "include-resource", []string{"*"}, "Resources to include in the backup")
+	flags.StringSliceVar(&options.excludedResources, "exclude-resource", nil, "Resources to exclude from the backup")
+	flags.Bool("snapshot-volumes", true, "Take snapshots of the persistent volumes")
+	flags.Bool("include-cluster-resources", true, "Include cluster-scoped resources in the backup")
+	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for the backup to finish")
+	flags.IntVarP(&options.interval, "interval", "i", 5, "Interval in seconds for polling backup status")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "back up")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	if _, err := time.ParseDuration(options.ttl); err != nil {
+		return errors.WrapIff(err, "invalid TTL %q", options.ttl)
+	}
+
+	if options.name == "" {
+		name := fmt.Sprintf("%s-%s", options.ClusterName(), time.Now().UTC().Format("20060102150405"))
+		if banzaiCli.Interactive() {
+			if err := survey.AskOne(&survey.Input{Message: "Backup name:", Default: name}, &name, survey.WithValidator(survey.Required)); err != nil {
+				return errors.WrapIf(err, "failed to get backup name")
+			}
+		}
+		options.name = name
+	}
+
+	request := createBackupRequest{
+		Name: options.name,
+		Ttl:  options.ttl,
+		Options: ark.BackupOptions{
+			IncludedNamespaces:      options.includedNamespaces,
+			ExcludedNamespaces:      options.excludedNamespaces,
+			IncludedResources:       options.includedResources,
+			ExcludedResources:       options.excludedResources,
+			SnapshotVolumes:         options.snapshotVolumes,
+			IncludeClusterResources: options.includeClusterResources,
+		},
+	}
+
+	log.Debugf("create backup request: %#v", request)
+	var response pipeline.CreateBackupResponse
+	if err := ark.Post(banzaiCli, ark.ClusterPath(orgID, clusterID, "backups"), request, &response); err != nil {
+		return errors.WrapIf(err, "failed to create backup")
+	}
+
+	log.Infof("backup %q is being created", options.name)
+
+	if !options.wait {
+		log.Infof("you can check its status with the command `banzai cluster backup get --cluster %d %d`", clusterID, response.Id)
+		return nil
+	}
+
+	return waitForBackup(banzaiCli, orgID, clusterID, response.Id, options.interval)
+}
+
+func waitForBackup(banzaiCli cli.Cli, orgID, clusterID, backupID int32, interval int) error {
+	for {
+		backup, _, err := banzaiCli.Client().ArkBackupsApi.GetARKBackup(context.Background(), orgID, clusterID, backupID)
+		if err != nil {
+			cli.LogAPIError("get backup", err, backupID)
+		} else if IsFinished(backup.Status) {
+			format.BackupWrite(banzaiCli, backup)
+
+			if backup.Status != PhaseCompleted {
+				return errors.Errorf("backup finished with status %s", backup.Status)
+			}
+
+			return nil
+		} else {
+			log.Debugf("backup status: %s", backup.Status)
+		}
+
+		time.Sleep(time.Duration(interval) * time.Second)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai cluster backup delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAME|ID]",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete a backup",
+		Long:    "Delete a backup. The backup to delete is identified either by its name or the numerical ID. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete backup of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var ref string
+	if len(args) > 0 {
+		ref = args[0]
+	}
+
+	backup, err := GetBackup(banzaiCli, orgID, clusterID, ref)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		format.BackupWrite(banzaiCli, backup)
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the backup?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, _, err := banzaiCli.Client().ArkBackupsApi.DeleteARKBackup(context.Background(), orgID, clusterID, backup.Id); err != nil {
+		cli.LogAPIError("delete backup", err, backup.Id)
+		return errors.WrapIf(err, "failed to delete backup")
+	}
+
+	log.Infof("deleting backup %q", backup.Name)
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type disableOptions struct {
+	clustercontext.Context
+}
+
+// NewDisableCommand creates a new cobra.Command for `banzai cluster backup disable`.
+func NewDisableCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := disableOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Disable the backup service of a cluster",
+		Long:  "Disable the backup service of a cluster. Existing backups are kept in the bucket. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDisable(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "disable backups of")
+
+	return cmd
+}
+
+func runDisable(banzaiCli cli.Cli, options disableOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Do you want to DISABLE the backup service of cluster %q?", options.ClusterName())}, &confirmed)
+		if !confirmed {
+			return errors.New("disabling cancelled")
+		}
+	}
+
+	if _, _, err := banzaiCli.Client().ArkApi.DisableARK(context.Background(), orgID, clusterID); err != nil {
+		cli.LogAPIError("disable backup service", err, clusterID)
+		return errors.WrapIf(err, "failed to disable backup service")
+	}
+
+	log.Infof("backup service of cluster %q is being disabled", options.ClusterName())
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type downloadOptions struct {
+	clustercontext.Context
+
+	file string
+}
+
+// NewDownloadCommand creates a new cobra.Command for `banzai cluster backup download`.
+func NewDownloadCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := downloadOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "download [NAME|ID]",
+		Aliases: []string{"dl"},
+		Short:   "Download the contents of a backup",
+		Long:    "Download the contents of a backup as a gzipped tar archive. The archive is written to NAME.tar.gz by default, or to stdout if the file name is -.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDownload(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Name of the file to write the backup contents to")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "download backup of")
+
+	return cmd
+}
+
+func runDownload(banzaiCli cli.Cli, options downloadOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var ref string
+	if len(args) > 0 {
+		ref = args[0]
+	}
+
+	backup, err := GetBackup(banzaiCli, orgID, clusterID, ref)
+	if err != nil {
+		return err
+	}
+
+	filename := options.file
+	if filename == "" {
+		filename = fmt.Sprintf("%s.tar.gz", backup.Name)
+	}
+
+	out := banzaiCli.Out()
+	if filename != "-" {
+		file, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return errors.WrapIff(err, "failed to create %q", filename)
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	if err := downloadBackup(banzaiCli, orgID, clusterID, backup.Id, out); err != nil {
+		if filename != "-" {
+			_ = os.Remove(filename)
+		}
+		return err
+	}
+
+	if filename != "-" {
+		log.Infof("backup %q downloaded to %s", backup.Name, filename)
+	}
+
+	return nil
+}
+
+// downloadBackup streams the backup archive to out.
+// DownloadARKBackupContents of the generated client can't decode binary responses, so the request is sent directly.
+func downloadBackup(banzaiCli cli.Cli, orgID, clusterID, backupID int32, out io.Writer) error {
+	config := banzaiCli.Client().GetConfig()
+
+	url := fmt.Sprintf("%s/api/v1/orgs/%d/clusters/%d/backups/%d/download", config.BasePath, orgID, clusterID, backupID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create download request")
+	}
+	request.Header.Set("Accept", "application/x-gzip")
+	request.Header.Set("User-Agent", config.UserAgent)
+
+	response, err := config.HTTPClient.Do(request)
+	if err != nil {
+		return errors.WrapIf(err, "failed to download backup")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(response.Body)
+		log.Debugf("download response: %s", body)
+		return errors.Errorf("failed to download backup: %s", response.Status)
+	}
+
+	_, err = io.Copy(out, response.Body)
+	return errors.WrapIf(err, "failed to write backup contents")
+}
@@ -0,0 +1,228 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/bucket"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup/ark"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup/schedule"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// enableRequest is the backup service enable request accepted by Pipeline.
+// It differs from EnableArkRequest of the generated client only in its options.
+type enableRequest struct {
+	Cloud      string            `json:"cloud"`
+	BucketName string            `json:"bucketName"`
+	Schedule   string            `json:"schedule"`
+	Ttl        string            `json:"ttl"`
+	SecretId   string            `json:"secretId"`
+	Options    ark.BackupOptions `json:"options"`
+}
+
+type enableOptions struct {
+	clustercontext.Context
+
+	bucketName string
+	cloud      string
+	secretID   string
+
+	schedule                string
+	ttl                     string
+	includedNamespaces      []string
+	excludedNamespaces      []string
+	includedResources       []string
+	excludedResources       []string
+	snapshotVolumes         *bool
+	includeClusterResources *bool
+}
+
+// NewEnableCommand creates a new cobra.Command for `banzai cluster backup enable`.
+func NewEnableCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := enableOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Enable the backup service of a cluster",
+		Long: "Enable the backup service of a cluster. Backups are stored in an existing bucket managed by Pipeline, accessed with the given secret. " +
+			"In case of interactive mode banzai CLI will prompt for the bucket and the secret if they are not given.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			options.snapshotVolumes = ark.ChangedBool(cmd.Flags(), "snapshot-volumes")
+			options.includeClusterResources = ark.ChangedBool(cmd.Flags(), "include-cluster-resources")
+
+			return runEnable(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.bucketName, "bucket", "b", "", "Name of the bucket to store the backups in")
+	flags.StringVarP(&options.cloud, "cloud", "c", "", "Cloud provider of the bucket")
+	flags.StringVarP(&options.secretID, "secret-id", "s", "", "Secret ID of the secret to access the bucket with")
+	flags.StringVar(&options.schedule, "schedule", "0 */6 * * *", "Cron expression of the default backup schedule")
+	flags.StringVar(&options.ttl, "ttl", "720h0m0s", "How long the scheduled backups are kept")
+	flags.StringSliceVar(&options.includedNamespaces, "include-namespace", []string{"*"}, "Namespaces to include in the scheduled backups")
+	flags.StringSliceVar(&options.excludedNamespaces, "exclude-namespace", nil, "Namespaces to exclude from the scheduled backups")
+	flags.StringSliceVar(&options.includedResources, "include-resource", []string{"*"}, "Resources to include in the scheduled backups")
+	flags.StringSliceVar(&options.excludedResources, "exclude-resource", nil, "Resources to exclude from the scheduled backups")
+	flags.Bool("snapshot-volumes", true, "Take snapshots of the persistent volumes")
+	flags.Bool("include-cluster-resources", true, "Include cluster-scoped resources in the scheduled backups")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "enable backups of")
+
+	return cmd
+}
+
+func runEnable(banzaiCli cli.Cli, options enableOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	if options.cloud != "" {
+		if err := input.IsCloudProviderSupported(options.cloud); err != nil {
+			return err
+		}
+	}
+
+	selectedBucket, err := selectBucket(banzaiCli, orgID, options.bucketName, options.cloud)
+	if err != nil {
+		return err
+	}
+
+	if options.secretID == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("--secret-id must be specified")
+		}
+
+		options.secretID, err = input.AskSecret(banzaiCli, orgID, selectedBucket.Cloud)
+		if err != nil {
+			return err
+		}
+	}
+
+	secret, _, err := banzaiCli.Client().SecretsApi.GetSecret(context.Background(), orgID, options.secretID)
+	if err != nil {
+		return errors.WrapIf(utils.ConvertError(errors.WithStack(err)), "could not get secret")
+	}
+
+	if secret.Type != selectedBucket.Cloud {
+		return errors.Errorf("mismatching secret type '%s' for cloud '%s'", secret.Type, selectedBucket.Cloud)
+	}
+
+	if banzaiCli.Interactive() {
+		validator := func(val interface{}) error {
+			return schedule.ValidateCron(val.(string))
+		}
+		if err := survey.AskOne(&survey.Input{Message: "Backup schedule:", Default: options.schedule}, &options.schedule, survey.WithValidator(validator)); err != nil {
+			return errors.WrapIf(err, "failed to get backup schedule")
+		}
+	}
+
+	if err := schedule.ValidateCron(options.schedule); err != nil {
+		return errors.WrapIff(err, "invalid cron expression %q", options.schedule)
+	}
+
+	if _, err := time.ParseDuration(options.ttl); err != nil {
+		return errors.WrapIff(err, "invalid TTL %q", options.ttl)
+	}
+
+	request := enableRequest{
+		Cloud:      selectedBucket.Cloud,
+		BucketName: selectedBucket.Name,
+		Schedule:   options.schedule,
+		Ttl:        options.ttl,
+		SecretId:   options.secretID,
+		Options: ark.BackupOptions{
+			IncludedNamespaces:      options.includedNamespaces,
+			ExcludedNamespaces:      options.excludedNamespaces,
+			IncludedResources:       options.includedResources,
+			ExcludedResources:       options.excludedResources,
+			SnapshotVolumes:         options.snapshotVolumes,
+			IncludeClusterResources: options.includeClusterResources,
+		},
+	}
+
+	log.Debugf("enable backup service request: %#v", request)
+	if err := ark.Post(banzaiCli, ark.ClusterPath(orgID, clusterID, "backupservice/enable"), request, nil); err != nil {
+		return errors.WrapIf(err, "failed to enable backup service")
+	}
+
+	log.Infof("backup service of cluster %q is being enabled, using bucket %q", options.ClusterName(), selectedBucket.Name)
+	return nil
+}
+
+// selectBucket selects the bucket to store backups in from the buckets managed by Pipeline.
+func selectBucket(banzaiCli cli.Cli, orgID int32, name, cloud string) (bucket.Bucket, error) {
+	buckets, err := bucket.GetManagedBuckets(banzaiCli, orgID, cloud, "")
+	if err != nil {
+		return bucket.Bucket{}, err
+	}
+
+	if name == "" {
+		if !banzaiCli.Interactive() {
+			return bucket.Bucket{}, errors.New("--bucket must be specified")
+		}
+
+		if len(buckets) == 0 {
+			return bucket.Bucket{}, errors.New("there are no buckets managed by Pipeline; create one with `banzai bucket create`")
+		}
+
+		options := make([]string, len(buckets))
+		bucketsByName := make(map[string]bucket.Bucket, len(buckets))
+		for i, b := range buckets {
+			options[i] = b.GetNameForSelection()
+			bucketsByName[options[i]] = b
+		}
+
+		var selected string
+		if err := survey.AskOne(&survey.Select{Message: "Bucket:", Options: options}, &selected, survey.WithValidator(survey.Required)); err != nil {
+			return bucket.Bucket{}, errors.WrapIf(err, "failed to select bucket")
+		}
+
+		return bucketsByName[selected], nil
+	}
+
+	var matches []bucket.Bucket
+	for _, b := range buckets {
+		if b.Name == name {
+			matches = append(matches, b)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return bucket.Bucket{}, errors.Errorf("no such bucket: %s", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return bucket.Bucket{}, errors.Errorf("there are buckets named %q on several clouds; specify the cloud with --cloud", name)
+	}
+}
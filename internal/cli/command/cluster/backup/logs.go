@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type logsOptions struct {
+	clustercontext.Context
+}
+
+// NewLogsCommand creates a new cobra.Command for `banzai cluster backup logs`.
+func NewLogsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := logsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "logs [NAME|ID]",
+		Aliases: []string{"log"},
+		Short:   "Show the logs of a backup",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runLogs(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "show backup logs of")
+
+	return cmd
+}
+
+func runLogs(banzaiCli cli.Cli, options logsOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var ref string
+	if len(args) > 0 {
+		ref = args[0]
+	}
+
+	backup, err := GetBackup(banzaiCli, orgID, clusterID, ref)
+	if err != nil {
+		return err
+	}
+
+	logs, _, err := banzaiCli.Client().ArkBackupsApi.GetARKBackupLogs(context.Background(), orgID, clusterID, backup.Id)
+	if err != nil {
+		cli.LogAPIError("get backup logs", err, backup.Id)
+		return errors.WrapIf(err, "could not get backup logs")
+	}
+
+	_, err = fmt.Fprint(banzaiCli.Out(), logs)
+	return errors.WrapIf(err, "failed to write backup logs")
+}
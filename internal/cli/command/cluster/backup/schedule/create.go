@@ -0,0 +1,234 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup/ark"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// scheduleRequest is the backup schedule create request accepted by Pipeline.
+// It is a superset of CreateScheduleRequest of the generated client, which can't hold arbitrary labels and label selectors.
+type scheduleRequest struct {
+	Name     string            `json:"name"`
+	Schedule string            `json:"schedule"`
+	Ttl      string            `json:"ttl"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Options  ark.BackupOptions `json:"options"`
+}
+
+type createOptions struct {
+	clustercontext.Context
+
+	file string
+
+	schedule                string
+	ttl                     string
+	labels                  map[string]string
+	selector                map[string]string
+	includedNamespaces      []string
+	excludedNamespaces      []string
+	includedResources       []string
+	excludedResources       []string
+	snapshotVolumes         *bool
+	includeClusterResources *bool
+
+	preview int
+	dryRun  bool
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai cluster backup schedule create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [NAME]",
+		Aliases: []string{"c"},
+		Short:   "Create a backup schedule for a cluster",
+		Long: "Create a backup schedule for a cluster. The schedule is given either with flags or as a YAML/JSON file. " +
+			"The cron expression is validated locally, and the next run times are shown before the schedule is submitted.",
+		Example: `  banzai cluster backup schedule create nightly --schedule "0 2 * * *" --ttl 168h --exclude-namespace kube-system
+  banzai cluster backup schedule create --file schedule.yaml --dry-run`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			options.snapshotVolumes = ark.ChangedBool(cmd.Flags(), "snapshot-volumes")
+			options.includeClusterResources = ark.ChangedBool(cmd.Flags(), "include-cluster-resources")
+
+			return runCreate(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Schedule descriptor file")
+	flags.StringVar(&options.schedule, "schedule", "", "Cron expression of the schedule, e.g. \"0 */6 * * *\" or \"@daily\"")
+	flags.StringVar(&options.ttl, "ttl", "720h0m0s", "How long the backups are kept")
+	flags.StringToStringVar(&options.labels, "label", nil, "Labels to add to the backups")
+	flags.StringToStringVar(&options.selector, "selector", nil, "Only back up resources matching these labels")
+	flags.StringSliceVar(&options.includedNamespaces, "include-namespace", []string{"*"}, "Namespaces to include in the backups")
+	flags.StringSliceVar(&options.excludedNamespaces, "exclude-namespace", nil, "Namespaces to exclude from the backups")
+	flags.StringSliceVar(&options.includedResources, "include-resource", []string{"*"}, "Resources to include in the backups")
+	flags.StringSliceVar(&options.excludedResources, "exclude-resource", nil, "Resources to exclude from the backups")
+	flags.Bool("snapshot-volumes", true, "Take snapshots of the persistent volumes")
+	flags.Bool("include-cluster-resources", true, "Include cluster-scoped resources in the backups")
+	flags.IntVar(&options.preview, "preview", 5, "Number of upcoming run times to show")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "Validate the schedule and show the upcoming run times without creating it")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "schedule backups of")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var request scheduleRequest
+	if options.file != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.file)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal input")
+		}
+	} else {
+		request = scheduleRequest{
+			Schedule: options.schedule,
+			Ttl:      options.ttl,
+			Labels:   options.labels,
+			Options: ark.BackupOptions{
+				IncludedNamespaces:      options.includedNamespaces,
+				ExcludedNamespaces:      options.excludedNamespaces,
+				IncludedResources:       options.includedResources,
+				ExcludedResources:       options.excludedResources,
+				SnapshotVolumes:         options.snapshotVolumes,
+				IncludeClusterResources: options.includeClusterResources,
+			},
+		}
+
+		if len(options.selector) > 0 {
+			request.Options.LabelSelector = &ark.LabelSelector{MatchLabels: options.selector}
+		}
+	}
+
+	if len(args) > 0 {
+		request.Name = args[0]
+	}
+
+	if banzaiCli.Interactive() {
+		if request.Name == "" {
+			if err := survey.AskOne(&survey.Input{Message: "Schedule name:"}, &request.Name, survey.WithValidator(survey.Required)); err != nil {
+				return errors.WrapIf(err, "failed to get schedule name")
+			}
+		}
+
+		if request.Schedule == "" {
+			validator := func(val interface{}) error {
+				return ValidateCron(val.(string))
+			}
+			if err := survey.AskOne(&survey.Input{Message: "Cron expression:", Default: "@daily"}, &request.Schedule, survey.WithValidator(validator)); err != nil {
+				return errors.WrapIf(err, "failed to get cron expression")
+			}
+		}
+	}
+
+	schedule, err := validateScheduleRequest(request)
+	if err != nil {
+		return err
+	}
+
+	if options.preview > 0 {
+		writeNextRuns(banzaiCli, schedule, options.preview)
+	}
+
+	if options.dryRun {
+		return nil
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := true
+		if err := survey.AskOne(&survey.Confirm{Message: fmt.Sprintf("Do you want to create the backup schedule %q?", request.Name), Default: true}, &confirmed); err != nil || !confirmed {
+			return errors.New("creation cancelled")
+		}
+	}
+
+	log.Debugf("create backup schedule request: %#v", request)
+	if err := ark.Post(banzaiCli, ark.ClusterPath(orgID, clusterID, "schedules"), request, nil); err != nil {
+		return errors.WrapIf(err, "failed to create backup schedule")
+	}
+
+	log.Infof("backup schedule %q created", request.Name)
+	return nil
+}
+
+func validateScheduleRequest(request scheduleRequest) (cronSchedule, error) {
+	if request.Name == "" {
+		return cronSchedule{}, errors.New("schedule name is required")
+	}
+
+	if request.Schedule == "" {
+		return cronSchedule{}, errors.New("cron expression is required")
+	}
+
+	schedule, err := parseCron(request.Schedule)
+	if err != nil {
+		return cronSchedule{}, errors.WrapIff(err, "invalid cron expression %q", request.Schedule)
+	}
+
+	if _, err := time.ParseDuration(request.Ttl); err != nil {
+		return cronSchedule{}, errors.WrapIff(err, "invalid TTL %q", request.Ttl)
+	}
+
+	return schedule, nil
+}
+
+// writeNextRuns writes the next n run times of the schedule, as seen by the backup controller running in UTC.
+func writeNextRuns(banzaiCli cli.Cli, schedule cronSchedule, n int) {
+	next := schedule.nextN(time.Now().UTC(), n)
+	if len(next) == 0 {
+		log.Warning("the schedule won't run in the next five years")
+		return
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		for _, t := range next {
+			log.Infof("next run: %s", t.Format(time.RFC3339))
+		}
+		return
+	}
+
+	fmt.Fprintln(banzaiCli.Out(), "Next runs (UTC):")
+	for _, t := range next {
+		fmt.Fprintf(banzaiCli.Out(), "  %s\n", t.Format("Mon, 02 Jan 2006 15:04"))
+	}
+}
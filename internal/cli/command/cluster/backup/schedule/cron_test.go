@@ -0,0 +1,118 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{"0 */6 * * *", true},
+		{"30 2 * * mon-fri", true},
+		{"0 0 1,15 * *", true},
+		{"0 0 * jan,jul sun", true},
+		{"0 0 * * 7", true},
+		{"5/10 * * * *", true},
+		{"@daily", true},
+		{"@every 1h30m", true},
+		{"", false},
+		{"* * * *", false},
+		{"* * * * * *", false},
+		{"60 * * * *", false},
+		{"* 24 * * *", false},
+		{"* * 0 * *", false},
+		{"* * * 13 *", false},
+		{"* * * * 8", false},
+		{"*/0 * * * *", false},
+		{"10-5 * * * *", false},
+		{"a * * * *", false},
+		{"@fortnightly", false},
+		{"@every soon", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.expr, func(t *testing.T) {
+			_, err := parseCron(test.expr)
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNextN(t *testing.T) {
+	// 2020-02-27 is a Thursday
+	start := time.Date(2020, time.February, 27, 10, 17, 42, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected []string
+	}{
+		{
+			expr:     "0 */6 * * *",
+			expected: []string{"2020-02-27T12:00", "2020-02-27T18:00", "2020-02-28T00:00"},
+		},
+		{
+			expr:     "30 2 * * mon-fri",
+			expected: []string{"2020-02-28T02:30", "2020-03-02T02:30", "2020-03-03T02:30"},
+		},
+		{
+			expr:     "0 0 29 2 *",
+			expected: []string{"2020-02-29T00:00", "2024-02-29T00:00", "2028-02-29T00:00"},
+		},
+		{
+			// day of month and day of week are ORed if neither is a wildcard
+			expr:     "0 12 1 * sun",
+			expected: []string{"2020-03-01T12:00", "2020-03-08T12:00", "2020-03-15T12:00"},
+		},
+		{
+			expr:     "@monthly",
+			expected: []string{"2020-03-01T00:00", "2020-04-01T00:00", "2020-05-01T00:00"},
+		},
+		{
+			expr:     "@every 2h",
+			expected: []string{"2020-02-27T12:17", "2020-02-27T14:17", "2020-02-27T16:17"},
+		},
+		{
+			expr:     "0 0 30 2 *",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.expr, func(t *testing.T) {
+			schedule, err := parseCron(test.expr)
+			require.NoError(t, err)
+
+			var actual []string
+			for _, next := range schedule.nextN(start, 3) {
+				actual = append(actual, next.Format("2006-01-02T15:04"))
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai cluster backup schedule delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAME]",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete a backup schedule",
+		Long:    "Delete a backup schedule. Backups already taken by the schedule are kept. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete backup schedule of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	schedule, err := getSchedule(banzaiCli, orgID, clusterID, name)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		format.BackupScheduleWrite(banzaiCli, schedule)
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the backup schedule?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, _, err := banzaiCli.Client().ArkSchedulesApi.DeleteARKSchedule(context.Background(), orgID, clusterID, schedule.Name); err != nil {
+		cli.LogAPIError("delete backup schedule", err, schedule.Name)
+		return errors.WrapIf(err, "failed to delete backup schedule")
+	}
+
+	log.Infof("backup schedule %q deleted", schedule.Name)
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type getOptions struct {
+	clustercontext.Context
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai cluster backup schedule get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get [NAME]",
+		Aliases: []string{"g", "show"},
+		Short:   "Get details of a backup schedule",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runGet(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get backup schedule of")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	schedule, err := getSchedule(banzaiCli, orgID, options.ClusterID(), name)
+	if err != nil {
+		return err
+	}
+
+	format.BackupScheduleWrite(banzaiCli, schedule)
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// getSchedule gets a backup schedule of a cluster by its name.
+// If name is empty, the user is asked to select one in interactive mode.
+func getSchedule(banzaiCli cli.Cli, orgID, clusterID int32, name string) (pipeline.ScheduleResponse, error) {
+	client := banzaiCli.Client()
+
+	if name != "" {
+		schedule, _, err := client.ArkSchedulesApi.GetARKSchedule(context.Background(), orgID, clusterID, name)
+		if err != nil {
+			cli.LogAPIError("get backup schedule", err, name)
+			return schedule, errors.WrapIff(err, "could not get backup schedule %q", name)
+		}
+
+		return schedule, nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return pipeline.ScheduleResponse{}, errors.New("no backup schedule is selected; specify the name of the schedule")
+	}
+
+	schedules, _, err := client.ArkSchedulesApi.ListARKSchedules(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list backup schedules", err, clusterID)
+		return pipeline.ScheduleResponse{}, errors.WrapIf(err, "could not list backup schedules")
+	}
+
+	if len(schedules) == 0 {
+		return pipeline.ScheduleResponse{}, errors.New("there are no backup schedules of the cluster")
+	}
+
+	names := make([]string, len(schedules))
+	for i, schedule := range schedules {
+		names[i] = schedule.Name
+	}
+
+	if err := survey.AskOne(&survey.Select{Message: "Backup schedule:", Options: names}, &name, survey.WithValidator(survey.Required)); err != nil {
+		return pipeline.ScheduleResponse{}, errors.WrapIf(err, "failed to select a backup schedule")
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Name == name {
+			return schedule, nil
+		}
+	}
+
+	return pipeline.ScheduleResponse{}, errors.Errorf("could not find backup schedule named %q", name)
+}
@@ -0,0 +1,73 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type statusOptions struct {
+	clustercontext.Context
+}
+
+// NewStatusCommand creates a new cobra.Command for `banzai cluster backup status`.
+func NewStatusCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := statusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Check whether the backup service of a cluster is enabled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runStatus(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "check backup service of")
+
+	return cmd
+}
+
+func runStatus(banzaiCli cli.Cli, options statusOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	status, response, err := banzaiCli.Client().ArkApi.CheckARKStatusGET(context.Background(), orgID, clusterID)
+	if err != nil {
+		// Pipeline responds with Not Found if the backup service is not deployed to the cluster
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			cli.LogAPIError("check backup service status", err, clusterID)
+			return errors.WrapIf(err, "failed to check backup service status")
+		}
+		status.Enabled = false
+	}
+
+	format.BackupServiceStatusWrite(banzaiCli, status)
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type syncOptions struct {
+	clustercontext.Context
+}
+
+// NewSyncCommand creates a new cobra.Command for `banzai cluster backup sync`.
+func NewSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := syncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Synchronize the backups of a cluster with the ones found in the backup bucket",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runSync(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "synchronize backups of")
+
+	return cmd
+}
+
+func runSync(banzaiCli cli.Cli, options syncOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	if _, err := banzaiCli.Client().ArkBackupsApi.SyncARKBackupsOfACluster(context.Background(), orgID, clusterID); err != nil {
+		cli.LogAPIError("sync backups", err, clusterID)
+		return errors.WrapIf(err, "failed to synchronize backups")
+	}
+
+	log.Infof("backups of cluster %q synchronized", options.ClusterName())
+	return nil
+}
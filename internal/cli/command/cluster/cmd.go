@@ -19,8 +19,17 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/autoscale"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/deployment"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/image"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/integratedservice"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/namespace"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/node"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/pke"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/restore"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/scanlog"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/whitelist"
 )
 
 // NewClusterCommand returns a cobra command for `cluster` subcommands.
@@ -37,11 +46,22 @@ func NewClusterCommand(banzaiCli cli.Cli) *cobra.Command {
 		NewGetCommand(banzaiCli),
 		NewHelmCommand(banzaiCli),
 		NewImportCommand(banzaiCli),
+		NewKubeconfigCommand(banzaiCli),
 		NewListCommand(banzaiCli),
 		NewShellCommand(banzaiCli),
+		NewUpdateCommand(banzaiCli),
+		autoscale.NewAutoscaleCommand(banzaiCli),
+		backup.NewBackupCommand(banzaiCli),
+		deployment.NewDeploymentCommand(banzaiCli),
+		image.NewImageCommand(banzaiCli),
 		integratedservice.NewIntegratedServiceCommand(banzaiCli),
+		namespace.NewNamespaceCommand(banzaiCli),
 		node.NewNodeCommand(banzaiCli),
 		nodepool.NewNodePoolCommand(banzaiCli),
+		pke.NewPKECommand(banzaiCli),
+		restore.NewRestoreCommand(banzaiCli),
+		scanlog.NewScanLogCommand(banzaiCli),
+		whitelist.NewWhitelistCommand(banzaiCli),
 	)
 
 	return cmd
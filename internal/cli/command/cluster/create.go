@@ -341,18 +341,14 @@ func buildInteractiveCreateRequest(banzaiCli cli.Cli, options createOptions, org
 		out["name"] = name
 	}
 
-	if out["type"] == "pke-on-azure" && out["resourceGroup"] == "" {
-		rgs, _, err := banzaiCli.Client().InfoApi.GetResourceGroups(context.Background(), orgID, secretID)
+	if rg, _ := out["resourceGroup"].(string); out["type"] == "pke-on-azure" && rg == "" {
+		location, _ := out["location"].(string)
+		resourceGroup, err := input.AskOrCreateResourceGroup(banzaiCli, orgID, secretID, location, "")
 		if err != nil {
-			return errors.WrapIf(err, "can't list resource groups")
+			return err
 		}
 
-		var rg string
-		if err = survey.AskOne(&survey.Select{Message: "Resource group:", Options: rgs}, &rg); err == nil {
-			out["resourceGroup"] = rg
-		} else {
-			log.Error("no resource group selected")
-		}
+		out["resourceGroup"] = resourceGroup
 	}
 
 	// recommend cluster layout and enable Hollowtrees in case of EKS
@@ -366,6 +362,11 @@ func buildInteractiveCreateRequest(banzaiCli cli.Cli, options createOptions, org
 		}
 	}
 
+	// offer existing VPC networks and subnets in case of EKS and PKE on AWS
+	if err := buildInteractiveNetworkChoice(banzaiCli, orgID, secretID, out); err != nil {
+		return err
+	}
+
 	for {
 		if bytes, err := json.MarshalIndent(out, "", "  "); err != nil {
 			log.Errorf("failed to marshal request: %v", err)
@@ -0,0 +1,136 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+// buildInteractiveNetworkChoice offers the existing VPC networks and subnets of the cloud provider
+// for EKS and PKE on AWS create requests which do not specify a VPC yet.
+func buildInteractiveNetworkChoice(banzaiCli cli.Cli, orgID int32, secretID string, out map[string]interface{}) error {
+	if cloud, _ := out["cloud"].(string); cloud != input.CloudProviderAmazon {
+		return nil
+	}
+
+	_, isEKS, _ := unstructured.NestedMap(out, "properties", "eks")
+	_, isPKE, _ := unstructured.NestedSlice(out, "properties", "pke", "nodePools")
+	if !isEKS && !isPKE {
+		return nil
+	}
+
+	if vpcID, _, _ := unstructured.NestedString(out, "properties", "eks", "vpc", "vpcId"); vpcID != "" {
+		return nil
+	}
+
+	var existing bool
+	_ = survey.AskOne(&survey.Confirm{Message: "Do you want to use an existing VPC network?"}, &existing)
+	if !existing {
+		return nil
+	}
+
+	region, _ := out["location"].(string)
+	if region == "" {
+		var err error
+		if region, err = input.AskLocation(banzaiCli, input.CloudProviderAmazon); err != nil {
+			return err
+		}
+		out["location"] = region
+	}
+
+	location := input.NetworkLocation{
+		SecretID: secretID,
+		Cloud:    input.CloudProviderAmazon,
+		Region:   region,
+	}
+
+	vpcID, err := input.AskVPCNetwork(banzaiCli, orgID, location)
+	if err != nil {
+		return err
+	}
+
+	subnets, err := input.AskVPCSubnets(banzaiCli, orgID, location, vpcID)
+	if err != nil {
+		return err
+	}
+
+	if isEKS {
+		eksSubnets := make([]interface{}, len(subnets))
+		for i, subnet := range subnets {
+			eksSubnets[i] = map[string]interface{}{
+				"subnetId":         subnet.Id,
+				"availabilityZone": subnet.Location,
+			}
+		}
+
+		if err := unstructured.SetNestedField(out, vpcID, "properties", "eks", "vpc", "vpcId"); err != nil {
+			return errors.WrapIf(err, "failed to set VPC network")
+		}
+		if err := unstructured.SetNestedSlice(out, eksSubnets, "properties", "eks", "subnets"); err != nil {
+			return errors.WrapIf(err, "failed to set subnets")
+		}
+
+		var setRouteTable bool
+		_ = survey.AskOne(&survey.Confirm{Message: "Do you want to select the route table of the subnets?"}, &setRouteTable)
+		if setRouteTable {
+			routeTableID, err := input.AskRouteTable(banzaiCli, orgID, location, vpcID)
+			if err != nil {
+				return err
+			}
+
+			if err := unstructured.SetNestedField(out, routeTableID, "properties", "eks", "routeTableId"); err != nil {
+				return errors.WrapIf(err, "failed to set route table")
+			}
+		}
+
+		return nil
+	}
+
+	subnetIDs := make([]interface{}, len(subnets))
+	var zones []interface{}
+	for i, subnet := range subnets {
+		subnetIDs[i] = subnet.Id
+		if subnet.Location != "" {
+			zones = append(zones, subnet.Location)
+		}
+	}
+
+	nodePools, _, _ := unstructured.NestedSlice(out, "properties", "pke", "nodePools")
+	for i := range nodePools {
+		nodePool, ok := nodePools[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := unstructured.SetNestedField(nodePool, vpcID, "providerConfig", "autoScalingGroup", "vpcID"); err != nil {
+			return errors.WrapIf(err, "failed to set VPC network")
+		}
+		if err := unstructured.SetNestedSlice(nodePool, subnetIDs, "providerConfig", "autoScalingGroup", "subnets"); err != nil {
+			return errors.WrapIf(err, "failed to set subnets")
+		}
+		if len(zones) > 0 {
+			if err := unstructured.SetNestedSlice(nodePool, zones, "providerConfig", "autoScalingGroup", "zones"); err != nil {
+				return errors.WrapIf(err, "failed to set zones")
+			}
+		}
+	}
+
+	return unstructured.SetNestedSlice(out, nodePools, "properties", "pke", "nodePools")
+}
@@ -0,0 +1,109 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type createOptions struct {
+	clustercontext.Context
+	requestOptions
+
+	releaseName string
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai cluster deployment create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [CHART]",
+		Aliases: []string{"c", "install"},
+		Short:   "Deploy a Helm chart to a cluster",
+		Long:    "Deploy a Helm chart to a cluster. The request can be given as a YAML/JSON file as well, and values files given with --values are merged into its values.",
+		Example: `
+		$ banzai cluster deployment create stable/nginx-ingress --cluster-name mycluster --release-name ingress --values values.yaml --wait`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runCreate(banzaiCli, options, cmd, args)
+		},
+	}
+
+	options.addFlags(cmd)
+	cmd.Flags().StringVarP(&options.releaseName, "release-name", "r", "", "Name of the release (generated if not given)")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "deploy to")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, cmd *cobra.Command, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	request, err := options.buildRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		request.Name = args[0]
+	}
+	if options.releaseName != "" {
+		request.ReleaseName = options.releaseName
+	}
+
+	if request.Name == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("CHART argument must be specified")
+		}
+
+		if err := survey.AskOne(&survey.Input{Message: "Chart:"}, &request.Name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get chart name")
+		}
+	}
+
+	log.Debugf("create deployment request: %#v", request)
+	response, _, err := banzaiCli.Client().DeploymentsApi.CreateDeployment(context.Background(), orgID, clusterID, request)
+	if err != nil {
+		cli.LogAPIError("create deployment", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to create deployment")
+	}
+
+	log.Infof("deployment %q is being created on cluster %q", response.ReleaseName, options.ClusterName())
+	writeNotes(banzaiCli, response.Notes)
+
+	if !options.wait || request.DryRun {
+		return nil
+	}
+
+	return waitForDeployment(banzaiCli, orgID, clusterID, response.ReleaseName, options.interval)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai cluster deployment delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [RELEASE_NAME]",
+		Aliases: []string{"d", "rm", "uninstall"},
+		Short:   "Delete a Helm deployment of a cluster",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete deployment of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterID, releaseName)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		deployment, _, err := client.DeploymentsApi.GetDeployment(context.Background(), orgID, clusterID, releaseName, nil)
+		if err != nil {
+			cli.LogAPIError("get deployment", err, releaseName)
+			return errors.WrapIff(err, "could not get deployment %q", releaseName)
+		}
+
+		format.DeploymentWrite(banzaiCli, deployment)
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the deployment?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	_, _, err = client.DeploymentsApi.DeleteDeployment(context.Background(), orgID, clusterID, releaseName)
+	if err != nil {
+		cli.LogAPIError("delete deployment", err, releaseName)
+		return errors.WrapIf(utils.ConvertError(err), "failed to delete deployment")
+	}
+
+	log.Infof("deployment %q deleted", releaseName)
+
+	return nil
+}
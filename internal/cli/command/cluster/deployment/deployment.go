@@ -0,0 +1,165 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// requestOptions are the options shared by the create and update commands.
+type requestOptions struct {
+	file        string
+	version     string
+	namespace   string
+	valuesFiles []string
+	dryRun      bool
+
+	wait     bool
+	interval int
+}
+
+func (o *requestOptions) addFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVarP(&o.file, "file", "f", "", "Deployment descriptor file")
+	flags.StringVar(&o.version, "version", "", "Version of the chart to deploy (the latest one if not given)")
+	flags.StringVarP(&o.namespace, "namespace", "n", "", "Namespace to deploy the release to")
+	flags.StringSliceVar(&o.valuesFiles, "values", nil, "Helm values file (can be repeated)")
+	flags.BoolVar(&o.dryRun, "dry-run", false, "Simulate the deployment")
+	flags.BoolVarP(&o.wait, "wait", "w", false, "Wait for the deployment to be ready")
+	flags.IntVarP(&o.interval, "interval", "i", 5, "Interval in seconds for polling deployment status")
+}
+
+// buildRequest assembles a deployment request from the descriptor file and the flags.
+func (o *requestOptions) buildRequest(cmd *cobra.Command) (pipeline.CreateUpdateDeploymentRequest, error) {
+	var request pipeline.CreateUpdateDeploymentRequest
+
+	if o.file != "" {
+		filename, raw, err := utils.ReadFileOrStdin(o.file)
+		if err != nil {
+			return request, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return request, errors.WrapIf(err, "failed to unmarshal input")
+		}
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("version") {
+		request.Version = o.version
+	}
+	if flags.Changed("namespace") {
+		request.Namespace = o.namespace
+	}
+	if flags.Changed("dry-run") {
+		request.DryRun = o.dryRun
+	}
+
+	if len(o.valuesFiles) > 0 {
+		values, err := utils.ReadValuesFiles(o.valuesFiles)
+		if err != nil {
+			return request, err
+		}
+
+		if request.Values == nil {
+			request.Values = map[string]interface{}{}
+		}
+		request.Values = utils.MergeValues(request.Values, values)
+	}
+
+	return request, nil
+}
+
+// getReleaseName returns the given release name, or asks the user to select one of the deployments of the cluster in interactive mode.
+func getReleaseName(banzaiCli cli.Cli, orgID, clusterID int32, releaseName string) (string, error) {
+	if releaseName != "" {
+		return releaseName, nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return "", errors.New("no deployment is selected; specify the release name of the deployment")
+	}
+
+	deployments, _, err := banzaiCli.Client().DeploymentsApi.ListDeployments(context.Background(), orgID, clusterID, nil)
+	if err != nil {
+		cli.LogAPIError("list deployments", err, clusterID)
+		return "", errors.WrapIf(err, "could not list deployments")
+	}
+
+	if len(deployments) == 0 {
+		return "", errors.New("there are no deployments on the cluster")
+	}
+
+	names := make([]string, len(deployments))
+	for i, deployment := range deployments {
+		names[i] = deployment.ReleaseName
+	}
+
+	if err := survey.AskOne(&survey.Select{Message: "Deployment:", Options: names}, &releaseName, survey.WithValidator(survey.Required)); err != nil {
+		return "", errors.WrapIf(err, "failed to select a deployment")
+	}
+
+	return releaseName, nil
+}
+
+// waitForDeployment polls the status of a deployment until it becomes ready.
+func waitForDeployment(banzaiCli cli.Cli, orgID, clusterID int32, releaseName string, interval int) error {
+	for {
+		resp, err := banzaiCli.Client().DeploymentsApi.HelmDeploymentStatus(context.Background(), orgID, clusterID, releaseName)
+		switch {
+		case resp == nil:
+			cli.LogAPIError("get deployment status", err, releaseName)
+		case resp.StatusCode == http.StatusOK:
+			log.Infof("deployment %q is ready", releaseName)
+			return nil
+		case resp.StatusCode == http.StatusNotFound:
+			return errors.Errorf("deployment %q not found", releaseName)
+		case err != nil:
+			return errors.WrapIff(err, "deployment %q is not healthy", releaseName)
+		default:
+			log.Debugf("deployment status: %s", resp.Status)
+		}
+
+		time.Sleep(time.Duration(interval) * time.Second)
+	}
+}
+
+// writeNotes writes the base64 encoded notes of a release to the output.
+func writeNotes(banzaiCli cli.Cli, notes string) {
+	if notes == "" {
+		return
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(notes)
+	if err != nil {
+		log.Debugf("failed to decode deployment notes: %v", err)
+		return
+	}
+
+	fmt.Fprintf(banzaiCli.Out(), "\nNotes\n----\n%s\n", decoded)
+}
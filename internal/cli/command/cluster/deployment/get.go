@@ -0,0 +1,83 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type getOptions struct {
+	clustercontext.Context
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai cluster deployment get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get [RELEASE_NAME]",
+		Aliases: []string{"g", "show"},
+		Short:   "Get details of a Helm deployment of a cluster",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runGet(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get deployment of")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, options.ClusterID(), releaseName)
+	if err != nil {
+		return err
+	}
+
+	deployment, _, err := banzaiCli.Client().DeploymentsApi.GetDeployment(context.Background(), orgID, options.ClusterID(), releaseName, nil)
+	if err != nil {
+		cli.LogAPIError("get deployment", err, releaseName)
+		return errors.WrapIff(err, "could not get deployment %q", releaseName)
+	}
+
+	format.DeploymentWrite(banzaiCli, deployment)
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault {
+		writeNotes(banzaiCli, deployment.Notes)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+type imagesOptions struct {
+	clustercontext.Context
+}
+
+// NewImagesCommand creates a new cobra.Command for `banzai cluster deployment images`.
+func NewImagesCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := imagesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "images [RELEASE_NAME]",
+		Aliases: []string{"image", "img"},
+		Short:   "List container images of a Helm deployment",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runImages(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list deployment images of")
+
+	return cmd
+}
+
+func runImages(banzaiCli cli.Cli, options imagesOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, options.ClusterID(), releaseName)
+	if err != nil {
+		return err
+	}
+
+	images, _, err := banzaiCli.Client().DeploymentsApi.GetDeploymentImages(context.Background(), orgID, options.ClusterID(), releaseName)
+	if err != nil {
+		cli.LogAPIError("list deployment images", err, releaseName)
+		return errors.WrapIff(err, "could not list images of deployment %q", releaseName)
+	}
+
+	format.DeploymentImagesWrite(banzaiCli, images)
+
+	return nil
+}
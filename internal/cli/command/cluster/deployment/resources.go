@@ -0,0 +1,110 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type resourcesOptions struct {
+	clustercontext.Context
+
+	types []string
+}
+
+type deploymentResource struct {
+	Kind string
+	Name string
+}
+
+// NewResourcesCommand creates a new cobra.Command for `banzai cluster deployment resources`.
+func NewResourcesCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := resourcesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "resources [RELEASE_NAME]",
+		Aliases: []string{"resource", "res"},
+		Short:   "List Kubernetes resources of a Helm deployment",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runResources(banzaiCli, options, args)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&options.types, "type", "t", nil, "Kubernetes resource types to list, for example deployment,service (all if not given)")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list deployment resources of")
+
+	return cmd
+}
+
+func runResources(banzaiCli cli.Cli, options resourcesOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, options.ClusterID(), releaseName)
+	if err != nil {
+		return err
+	}
+
+	opts := &pipeline.GetDeploymentResourceOpts{}
+	if len(options.types) > 0 {
+		opts.ResourceTypes = optional.NewString(strings.Join(options.types, ","))
+	}
+
+	resources, _, err := banzaiCli.Client().DeploymentsApi.GetDeploymentResource(context.Background(), orgID, options.ClusterID(), releaseName, opts)
+	if err != nil {
+		cli.LogAPIError("list deployment resources", err, releaseName)
+		return errors.WrapIff(err, "could not list resources of deployment %q", releaseName)
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.DeploymentResourcesWrite(banzaiCli, resources)
+		return nil
+	}
+
+	table := make([]deploymentResource, len(resources))
+	for i, resource := range resources {
+		table[i] = deploymentResource{
+			Kind: fmt.Sprint(resource["kind"]),
+			Name: fmt.Sprint(resource["name"]),
+		}
+	}
+
+	format.DeploymentResourcesWrite(banzaiCli, table)
+
+	return nil
+}
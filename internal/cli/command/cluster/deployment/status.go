@@ -0,0 +1,96 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"net/http"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+)
+
+type statusOptions struct {
+	clustercontext.Context
+
+	wait     bool
+	interval int
+}
+
+// NewStatusCommand creates a new cobra.Command for `banzai cluster deployment status`.
+func NewStatusCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := statusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status [RELEASE_NAME]",
+		Short: "Check whether a Helm deployment of a cluster is ready",
+		Long:  "Check whether a Helm deployment of a cluster is ready. The command exits with a non-zero code if it is not.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runStatus(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for the deployment to be ready")
+	flags.IntVarP(&options.interval, "interval", "i", 5, "Interval in seconds for polling deployment status")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "check deployment of")
+
+	return cmd
+}
+
+func runStatus(banzaiCli cli.Cli, options statusOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, options.ClusterID(), releaseName)
+	if err != nil {
+		return err
+	}
+
+	if options.wait {
+		return waitForDeployment(banzaiCli, orgID, options.ClusterID(), releaseName, options.interval)
+	}
+
+	resp, err := banzaiCli.Client().DeploymentsApi.HelmDeploymentStatus(context.Background(), orgID, options.ClusterID(), releaseName)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return errors.Errorf("deployment %q not found", releaseName)
+	}
+	if err != nil {
+		cli.LogAPIError("get deployment status", err, releaseName)
+		return errors.WrapIff(err, "deployment %q is not healthy", releaseName)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("deployment %q is not ready yet", releaseName)
+	}
+
+	log.Infof("deployment %q is ready", releaseName)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type updateOptions struct {
+	clustercontext.Context
+	requestOptions
+
+	chart       string
+	reuseValues bool
+}
+
+// NewUpdateCommand creates a new cobra.Command for `banzai cluster deployment update`.
+func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update [RELEASE_NAME]",
+		Aliases: []string{"u", "upgrade"},
+		Short:   "Update a Helm deployment of a cluster",
+		Long:    "Update a Helm deployment of a cluster. The namespace of the existing deployment is kept unless specified otherwise.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runUpdate(banzaiCli, options, cmd, args)
+		},
+	}
+
+	options.addFlags(cmd)
+	flags := cmd.Flags()
+	flags.StringVar(&options.chart, "chart", "", "Chart of the release, for example stable/nginx-ingress")
+	flags.BoolVar(&options.reuseValues, "reuse-values", false, "Reuse the values of the current release and merge the given ones into them")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "update")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions, cmd *cobra.Command, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterID, releaseName)
+	if err != nil {
+		return err
+	}
+
+	request, err := options.buildRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	current, _, err := client.DeploymentsApi.GetDeployment(context.Background(), orgID, clusterID, releaseName, nil)
+	if err != nil {
+		cli.LogAPIError("get deployment", err, releaseName)
+		return errors.WrapIff(err, "could not get deployment %q", releaseName)
+	}
+
+	request.ReleaseName = releaseName
+	if options.chart != "" {
+		request.Name = options.chart
+	}
+	if request.Name == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("the chart of the release must be specified with --chart")
+		}
+
+		if err := survey.AskOne(&survey.Input{Message: "Chart:", Default: current.ChartName}, &request.Name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get chart name")
+		}
+	}
+	if request.Namespace == "" {
+		request.Namespace = current.Namespace
+	}
+	if options.reuseValues {
+		request.ReuseValues = true
+	}
+
+	log.Debugf("update deployment request: %#v", request)
+	response, _, err := client.DeploymentsApi.UpdateDeployment(context.Background(), orgID, clusterID, releaseName, request)
+	if err != nil {
+		cli.LogAPIError("update deployment", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to update deployment")
+	}
+
+	log.Infof("deployment %q is being updated on cluster %q", response.ReleaseName, options.ClusterName())
+	writeNotes(banzaiCli, response.Notes)
+
+	if !options.wait || request.DryRun {
+		return nil
+	}
+
+	return waitForDeployment(banzaiCli, orgID, clusterID, releaseName, options.interval)
+}
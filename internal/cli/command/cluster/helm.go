@@ -32,6 +32,7 @@ import (
 
 	"emperror.dev/errors"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/helm/repo"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	yaml "gopkg.in/yaml.v2"
@@ -120,8 +121,8 @@ func runHelm(banzaiCli cli.Cli, options helmOptions, args []string) error {
 	}
 
 	org := banzaiCli.Context().OrganizationID()
-	helmHome := filepath.Join(banzaiCli.Home(), fmt.Sprintf("helm/org-%d", org))
-	helmRepos := filepath.Join(helmHome, "repository")
+	helmHome := repo.HelmHome(banzaiCli, org)
+	helmRepos := repo.RepositoriesDir(banzaiCli, org)
 	if err := os.MkdirAll(helmRepos, 0755); err != nil {
 		return errors.WrapIff(err, "failed to create %q directory", bindir)
 	}
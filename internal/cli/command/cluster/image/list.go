@@ -0,0 +1,132 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type listOptions struct {
+	clustercontext.Context
+
+	deployments bool
+}
+
+// imageDeployments is an image with the deployments using it, as written in structured output formats.
+type imageDeployments struct {
+	pipeline.ClusterImage `yaml:",inline"`
+	Deployments           []pipeline.ListDeploymentsResponseItem `json:"deployments,omitempty" yaml:"deployments,omitempty"`
+}
+
+type imageRow struct {
+	ImageName   string
+	ImageTag    string
+	ImageDigest string
+	Deployments string
+}
+
+// NewListCommand creates a new cobra.Command for `banzai cluster image list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List container images running on a cluster",
+		Long:    "List container images running on a cluster with their digests, and the Helm deployments using each of them.",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.deployments, "deployments", true, "List the deployments using each image")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list images of")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	images, _, err := client.ImagesApi.ListImages(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list images", err, clusterID)
+		return errors.WrapIf(err, "could not list images")
+	}
+
+	items := make([]imageDeployments, len(images))
+	for i, image := range images {
+		items[i].ClusterImage = image
+
+		if !options.deployments || image.ImageDigest == "" {
+			continue
+		}
+
+		deployments, _, err := client.ImagesApi.ListDeploymentsByImage(context.Background(), orgID, clusterID, image.ImageDigest)
+		if err != nil {
+			cli.LogAPIError("list deployments by image", err, image.ImageDigest)
+			return errors.WrapIff(err, "could not list deployments of image %q", image.ImageDigest)
+		}
+		items[i].Deployments = deployments
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.ImagesWrite(banzaiCli, items)
+		return nil
+	}
+
+	rows := make([]imageRow, len(items))
+	for i, item := range items {
+		names := make([]string, len(item.Deployments))
+		for j, deployment := range item.Deployments {
+			names[j] = deployment.ReleaseName
+		}
+
+		rows[i] = imageRow{
+			ImageName:   item.ImageName,
+			ImageTag:    item.ImageTag,
+			ImageDigest: item.ImageDigest,
+			Deployments: strings.Join(names, ","),
+		}
+	}
+
+	if options.deployments {
+		format.ImageDeploymentsWrite(banzaiCli, rows)
+	} else {
+		format.ImagesWrite(banzaiCli, rows)
+	}
+
+	return nil
+}
@@ -102,6 +102,11 @@ func (r releaseSpec) Validate() error {
 	return nil
 }
 
+// ValidateWhitelistedRelease validates a release whitelist entry with the same rules as the ones of the service specification.
+func ValidateWhitelistedRelease(name, reason string) error {
+	return releaseSpec{Name: name, Reason: reason}.Validate()
+}
+
 type webHookConfigSpec struct {
 	Enabled    bool     `json:"enabled" mapstructure:"enabled"`
 	Selector   string   `json:"selector" mapstructure:"selector"`
@@ -0,0 +1,282 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/ghodss/yaml"
+	"github.com/mitchellh/go-homedir"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/client-go/tools/clientcmd/api/v1"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type kubeconfigOptions struct {
+	clustercontext.Context
+
+	file        string
+	merge       bool
+	contextName string
+	oidc        bool
+}
+
+// NewKubeconfigCommand creates a new cobra.Command for `banzai cluster kubeconfig`.
+func NewKubeconfigCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := kubeconfigOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "kubeconfig [--cluster=ID | [--cluster-name=]NAME]",
+		Aliases: []string{"kubecfg", "config"},
+		Short:   "Get the kubeconfig of a cluster",
+		Long:    "Get the kubeconfig of a cluster. The kubeconfig is written to the standard output or to a file, or merged into the kubeconfig of the user (~/.kube/config or the first path of $KUBECONFIG).",
+		Example: `
+		$ banzai cluster kubeconfig mycluster > mycluster.yaml
+		$ banzai cluster kubeconfig mycluster --file mycluster.yaml --oidc
+		$ banzai cluster kubeconfig mycluster --merge --context-name mycluster`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runKubeconfig(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.file, "file", "", "Write the kubeconfig to this file instead of the standard output")
+	flags.BoolVar(&options.merge, "merge", false, "Merge the kubeconfig into the kubeconfig of the user")
+	flags.StringVar(&options.contextName, "context-name", "", "Name of the context, cluster and user in the kubeconfig (default \"banzai-ORGANIZATION-CLUSTER\")")
+	flags.BoolVar(&options.oidc, "oidc", false, "Get a kubeconfig authenticating with OIDC (for clusters with OIDC enabled)")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get kubeconfig for")
+
+	return cmd
+}
+
+func runKubeconfig(banzaiCli cli.Cli, options kubeconfigOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if options.merge && options.file != "" {
+		return errors.New("--merge and --file can't be used together")
+	}
+
+	if err := options.Init(args...); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	getConfig := client.ClustersApi.GetClusterConfig
+	if options.oidc {
+		getConfig = client.ClustersApi.GetOIDCClusterConfig
+	}
+
+	clusterConfig, _, err := getConfig(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster config", err, clusterID)
+		return errors.WrapIf(utils.ConvertError(err), "could not get cluster config")
+	}
+
+	var config v1.Config
+	if err := yaml.Unmarshal([]byte(clusterConfig.Data), &config); err != nil {
+		return errors.WrapIf(err, "failed to parse kubeconfig of the cluster")
+	}
+
+	contextName := options.contextName
+	if contextName == "" && options.merge {
+		org, _, err := client.OrganizationsApi.GetOrg(context.Background(), orgID)
+		if err != nil {
+			cli.LogAPIError("get organization", err, orgID)
+			return errors.WrapIf(utils.ConvertError(err), "could not get organization")
+		}
+
+		contextName = fmt.Sprintf("banzai-%s-%s", org.Name, options.ClusterName())
+	}
+
+	if contextName != "" {
+		if config, err = renameKubeconfig(config, contextName); err != nil {
+			return err
+		}
+	}
+
+	if !options.merge {
+		data, err := yaml.Marshal(config)
+		if err != nil {
+			return errors.WrapIf(err, "failed to marshal kubeconfig")
+		}
+
+		if options.file == "" {
+			_, err := banzaiCli.Out().Write(data)
+			return errors.WrapIf(err, "failed to write kubeconfig")
+		}
+
+		if err := ioutil.WriteFile(options.file, data, 0600); err != nil {
+			return errors.WrapIfWithDetails(err, "failed to write kubeconfig", "filename", options.file)
+		}
+
+		log.Infof("kubeconfig of cluster %q written to %s", options.ClusterName(), options.file)
+		return nil
+	}
+
+	path, err := userKubeconfigPath()
+	if err != nil {
+		return err
+	}
+
+	var userConfig v1.Config
+	if raw, err := ioutil.ReadFile(path); err == nil {
+		if err := yaml.Unmarshal(raw, &userConfig); err != nil {
+			return errors.WrapIfWithDetails(err, "failed to parse kubeconfig", "filename", path)
+		}
+	} else if !os.IsNotExist(err) {
+		return errors.WrapIfWithDetails(err, "failed to read kubeconfig", "filename", path)
+	}
+
+	userConfig = mergeKubeconfig(userConfig, config)
+
+	data, err := yaml.Marshal(userConfig)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal kubeconfig")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.WrapIfWithDetails(err, "failed to create kubeconfig directory", "filename", path)
+	}
+
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return errors.WrapIfWithDetails(err, "failed to write kubeconfig", "filename", path)
+	}
+
+	log.Infof("kubeconfig of cluster %q merged into %s as context %q", options.ClusterName(), path, contextName)
+	if userConfig.CurrentContext != contextName {
+		log.Infof("you can switch to it with the command `kubectl config use-context %s`", contextName)
+	}
+
+	return nil
+}
+
+// userKubeconfigPath returns the path of the kubeconfig of the user, following the conventions of kubectl.
+func userKubeconfigPath() (string, error) {
+	if paths := filepath.SplitList(os.Getenv("KUBECONFIG")); len(paths) > 0 && strings.TrimSpace(paths[0]) != "" {
+		return paths[0], nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", errors.WrapIf(err, "could not determine home directory")
+	}
+
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+// renameKubeconfig names the only context of the kubeconfig and the cluster and user it refers to after the given name.
+func renameKubeconfig(config v1.Config, name string) (v1.Config, error) {
+	if len(config.Contexts) != 1 {
+		return config, errors.Errorf("expected exactly one context in the kubeconfig of the cluster, found %d", len(config.Contexts))
+	}
+
+	kubeContext := config.Contexts[0].Context
+
+	renamed := config
+	renamed.Clusters = nil
+	renamed.AuthInfos = nil
+
+	for _, cluster := range config.Clusters {
+		if cluster.Name == kubeContext.Cluster {
+			cluster.Name = name
+			renamed.Clusters = append(renamed.Clusters, cluster)
+		}
+	}
+
+	for _, authInfo := range config.AuthInfos {
+		if authInfo.Name == kubeContext.AuthInfo {
+			authInfo.Name = name
+			renamed.AuthInfos = append(renamed.AuthInfos, authInfo)
+		}
+	}
+
+	kubeContext.Cluster = name
+	kubeContext.AuthInfo = name
+	renamed.Contexts = []v1.NamedContext{{Name: name, Context: kubeContext}}
+	renamed.CurrentContext = name
+
+	return renamed, nil
+}
+
+// mergeKubeconfig adds the contexts, clusters and users of src to dst, replacing the ones with the same name.
+// The current context of dst is kept, unless it is not set.
+func mergeKubeconfig(dst, src v1.Config) v1.Config {
+	if dst.APIVersion == "" {
+		dst.APIVersion = "v1"
+	}
+	if dst.Kind == "" {
+		dst.Kind = "Config"
+	}
+
+	for _, cluster := range src.Clusters {
+		replaced := false
+		for i := range dst.Clusters {
+			if dst.Clusters[i].Name == cluster.Name {
+				dst.Clusters[i] = cluster
+				replaced = true
+			}
+		}
+		if !replaced {
+			dst.Clusters = append(dst.Clusters, cluster)
+		}
+	}
+
+	for _, authInfo := range src.AuthInfos {
+		replaced := false
+		for i := range dst.AuthInfos {
+			if dst.AuthInfos[i].Name == authInfo.Name {
+				dst.AuthInfos[i] = authInfo
+				replaced = true
+			}
+		}
+		if !replaced {
+			dst.AuthInfos = append(dst.AuthInfos, authInfo)
+		}
+	}
+
+	for _, kubeContext := range src.Contexts {
+		replaced := false
+		for i := range dst.Contexts {
+			if dst.Contexts[i].Name == kubeContext.Name {
+				dst.Contexts[i] = kubeContext
+				replaced = true
+			}
+		}
+		if !replaced {
+			dst.Contexts = append(dst.Contexts, kubeContext)
+		}
+	}
+
+	if dst.CurrentContext == "" {
+		dst.CurrentContext = src.CurrentContext
+	}
+
+	return dst
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+func TestRenameKubeconfig(t *testing.T) {
+	config := v1.Config{
+		Clusters:  []v1.NamedCluster{{Name: "mycluster", Cluster: v1.Cluster{Server: "https://1.2.3.4"}}},
+		AuthInfos: []v1.NamedAuthInfo{{Name: "mycluster-admin", AuthInfo: v1.AuthInfo{Token: "secret"}}},
+		Contexts: []v1.NamedContext{{
+			Name:    "mycluster-admin@mycluster",
+			Context: v1.Context{Cluster: "mycluster", AuthInfo: "mycluster-admin", Namespace: "default"},
+		}},
+		CurrentContext: "mycluster-admin@mycluster",
+	}
+
+	renamed, err := renameKubeconfig(config, "banzai-acme-mycluster")
+	require.NoError(t, err)
+
+	require.Equal(t, []v1.NamedCluster{{Name: "banzai-acme-mycluster", Cluster: v1.Cluster{Server: "https://1.2.3.4"}}}, renamed.Clusters)
+	require.Equal(t, []v1.NamedAuthInfo{{Name: "banzai-acme-mycluster", AuthInfo: v1.AuthInfo{Token: "secret"}}}, renamed.AuthInfos)
+	require.Equal(t, []v1.NamedContext{{
+		Name:    "banzai-acme-mycluster",
+		Context: v1.Context{Cluster: "banzai-acme-mycluster", AuthInfo: "banzai-acme-mycluster", Namespace: "default"},
+	}}, renamed.Contexts)
+	require.Equal(t, "banzai-acme-mycluster", renamed.CurrentContext)
+
+	_, err = renameKubeconfig(v1.Config{}, "banzai-acme-mycluster")
+	require.Error(t, err)
+}
+
+func TestMergeKubeconfig(t *testing.T) {
+	dst := v1.Config{
+		Clusters: []v1.NamedCluster{
+			{Name: "other", Cluster: v1.Cluster{Server: "https://other"}},
+			{Name: "banzai", Cluster: v1.Cluster{Server: "https://old"}},
+		},
+		AuthInfos:      []v1.NamedAuthInfo{{Name: "other"}},
+		Contexts:       []v1.NamedContext{{Name: "other", Context: v1.Context{Cluster: "other", AuthInfo: "other"}}},
+		CurrentContext: "other",
+	}
+	src := v1.Config{
+		Clusters:       []v1.NamedCluster{{Name: "banzai", Cluster: v1.Cluster{Server: "https://new"}}},
+		AuthInfos:      []v1.NamedAuthInfo{{Name: "banzai"}},
+		Contexts:       []v1.NamedContext{{Name: "banzai", Context: v1.Context{Cluster: "banzai", AuthInfo: "banzai"}}},
+		CurrentContext: "banzai",
+	}
+
+	merged := mergeKubeconfig(dst, src)
+
+	require.Equal(t, []v1.NamedCluster{
+		{Name: "other", Cluster: v1.Cluster{Server: "https://other"}},
+		{Name: "banzai", Cluster: v1.Cluster{Server: "https://new"}},
+	}, merged.Clusters)
+	require.Len(t, merged.AuthInfos, 2)
+	require.Len(t, merged.Contexts, 2)
+	require.Equal(t, "other", merged.CurrentContext)
+	require.Equal(t, "v1", merged.APIVersion)
+
+	require.Equal(t, "banzai", mergeKubeconfig(v1.Config{}, src).CurrentContext)
+}
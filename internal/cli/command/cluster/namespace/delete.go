@@ -0,0 +1,107 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type deleteOptions struct {
+	clustercontext.Context
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai cluster namespace delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAMESPACE]",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete a namespace of a cluster",
+		Long:    "Delete a namespace of a cluster with all of its resources. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var namespace string
+			if len(args) > 0 {
+				namespace = args[0]
+			}
+
+			return runDelete(banzaiCli, options, namespace)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "delete the namespace of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, namespace string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	if namespace == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("no namespace is selected; specify the NAMESPACE argument")
+		}
+
+		response, _, err := client.ClustersApi.ListNamespaces(context.Background(), orgID, clusterID)
+		if err != nil {
+			cli.LogAPIError("list namespaces", err, clusterID)
+			return errors.WrapIf(utils.ConvertError(err), "failed to list namespaces")
+		}
+
+		names := make([]string, len(response.Namespaces))
+		for i, namespace := range response.Namespaces {
+			names[i] = namespace.Name
+		}
+
+		if err := survey.AskOne(&survey.Select{Message: "Namespace:", Options: names}, &namespace, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to select a namespace")
+		}
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the namespace with all of its resources?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, err := client.ClustersApi.DeleteNamespace(context.Background(), orgID, clusterID, namespace); err != nil {
+		cli.LogAPIError("delete namespace", err, namespace)
+		return errors.WrapIf(utils.ConvertError(err), "failed to delete namespace")
+	}
+
+	log.Infof("namespace %q of cluster %q is being deleted", namespace, options.ClusterName())
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type listOptions struct {
+	clustercontext.Context
+}
+
+type namespaceRow struct {
+	ClusterId   int32
+	ClusterName string
+	Name        string
+}
+
+// NewListCommand creates a new cobra.Command for `banzai cluster namespace list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List namespaces of a cluster",
+		Long:    "List namespaces of a cluster. The --cluster-name option accepts a glob pattern to list the namespaces of several clusters.",
+		Example: `
+		$ banzai cluster namespace list --cluster-name mycluster
+		$ banzai cluster namespace list --cluster-name 'prod-*'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list namespaces of")
+	cmd.Flags().Lookup("cluster-name").Usage = "Name or glob pattern of clusters to list namespaces of"
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	clusters, err := selectClusters(banzaiCli, options.Context)
+	if err != nil {
+		return err
+	}
+
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var rows []namespaceRow
+	var errs []error
+	for _, cluster := range clusters {
+		response, _, err := banzaiCli.Client().ClustersApi.ListNamespaces(context.Background(), orgID, cluster.Id)
+		if err != nil {
+			cli.LogAPIError("list namespaces", err, cluster.Id)
+			errs = append(errs, errors.WrapIff(utils.ConvertError(err), "failed to list namespaces of cluster %q", cluster.Name))
+			continue
+		}
+
+		for _, namespace := range response.Namespaces {
+			rows = append(rows, namespaceRow{
+				ClusterId:   cluster.Id,
+				ClusterName: cluster.Name,
+				Name:        namespace.Name,
+			})
+		}
+	}
+
+	format.NamespacesWrite(banzaiCli, rows)
+
+	return errors.Combine(errs...)
+}
+
+// selectClusters returns the clusters selected by the cluster context.
+// If the cluster name is a glob pattern, every matching cluster of the organization is returned.
+func selectClusters(banzaiCli cli.Cli, clusterContext clustercontext.Context) ([]pipeline.GetClusterStatusResponse, error) {
+	pattern := clusterContext.ClusterName()
+	if !strings.ContainsAny(pattern, "*?[") {
+		if err := clusterContext.Init(); err != nil {
+			return nil, err
+		}
+
+		return []pipeline.GetClusterStatusResponse{{Id: clusterContext.ClusterID(), Name: clusterContext.ClusterName()}}, nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, errors.WrapIff(err, "invalid cluster name pattern %q", pattern)
+	}
+
+	orgID := banzaiCli.Context().OrganizationID()
+	clusters, _, err := banzaiCli.Client().ClustersApi.ListClusters(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list clusters", err, orgID)
+		return nil, errors.WrapIf(utils.ConvertError(err), "could not list clusters")
+	}
+
+	var selected []pipeline.GetClusterStatusResponse
+	for _, cluster := range clusters {
+		if ok, _ := path.Match(pattern, cluster.Name); ok {
+			selected = append(selected, cluster)
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, errors.Errorf("no cluster matches %q", pattern)
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,109 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type bootstrapOptions struct {
+	clustercontext.Context
+}
+
+// bootstrapInfo is the information needed by pke-installer to join a machine to the cluster
+type bootstrapInfo struct {
+	PipelineURL              string `json:"pipelineUrl"`
+	OrganizationID           int32  `json:"organizationId"`
+	ClusterID                int32  `json:"clusterId"`
+	ClusterName              string `json:"clusterName"`
+	MasterAddress            string `json:"masterAddress"`
+	Token                    string `json:"token"`
+	DiscoveryTokenCaCertHash string `json:"discoveryTokenCaCertHash"`
+}
+
+// NewBootstrapCommand creates a new cobra.Command for `banzai cluster pke bootstrap`.
+func NewBootstrapCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := bootstrapOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: "Print the bootstrap information of a PKE cluster",
+		Long:  "Print the bootstrap information of a PKE cluster as YAML (or JSON with --output=json), ready to be used in cloud-init templates.",
+		Example: `
+		$ banzai cluster pke bootstrap --cluster-name mycluster > bootstrap.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runBootstrap(banzaiCli, options)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get bootstrap information for")
+
+	return cmd
+}
+
+func runBootstrap(banzaiCli cli.Cli, options bootstrapOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	bootstrap, _, err := banzaiCli.Client().ClustersApi.GetClusterBootstrap(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("get cluster bootstrap", err, clusterID)
+		return errors.WrapIf(utils.ConvertError(err), "failed to get cluster bootstrap information")
+	}
+
+	info := bootstrapInfo{
+		PipelineURL:              banzaiCli.Client().GetConfig().BasePath,
+		OrganizationID:           orgID,
+		ClusterID:                clusterID,
+		ClusterName:              options.ClusterName(),
+		MasterAddress:            bootstrap.MasterAddress,
+		Token:                    bootstrap.Token,
+		DiscoveryTokenCaCertHash: bootstrap.DiscoveryTokenCaCertHash,
+	}
+
+	if banzaiCli.OutputFormat() == output.OutputFormatJSON {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: output.OutputFormatJSON,
+		}
+
+		return errors.WrapIf(output.Output(ctx, info), "failed to write bootstrap information")
+	}
+
+	data, err := yaml.Marshal(info)
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal bootstrap information")
+	}
+
+	_, err = banzaiCli.Out().Write(data)
+	return errors.WrapIf(err, "failed to write bootstrap information")
+}
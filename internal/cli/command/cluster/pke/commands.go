@@ -0,0 +1,155 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pke
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type commandsOptions struct {
+	clustercontext.Context
+
+	nodePool string
+}
+
+// NewCommandsCommand creates a new cobra.Command for `banzai cluster pke commands`.
+func NewCommandsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := commandsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "commands",
+		Aliases: []string{"command", "cmd"},
+		Short:   "Print the commands to join machines to the node pools of a PKE cluster",
+		Long:    "Print the pke-installer commands to run on the machines to join to the node pools of a PKE cluster. With --nodepool only the command is printed, so it can be used in scripts.",
+		Example: `
+		$ banzai cluster pke commands --cluster-name mycluster
+		$ ssh node1 "sudo $(banzai cluster pke commands --cluster-name mycluster --nodepool pool1)"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runCommands(banzaiCli, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.nodePool, "nodepool", "", "Print the command of this node pool only")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "get commands for")
+
+	return cmd
+}
+
+func runCommands(banzaiCli cli.Cli, options commandsOptions) error {
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	commands, err := getPKECommands(banzaiCli, banzaiCli.Context().OrganizationID(), options.ClusterID())
+	if err != nil {
+		return err
+	}
+
+	if options.nodePool != "" {
+		command, ok := commands[options.nodePool]
+		if !ok {
+			return errors.Errorf("could not find node pool %q in cluster %q", options.nodePool, options.ClusterName())
+		}
+
+		if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+			commands = map[string]string{options.nodePool: command}
+		} else {
+			_, err := fmt.Fprintln(banzaiCli.Out(), command)
+			return errors.WrapIf(err, "failed to write command")
+		}
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return errors.WrapIf(output.Output(ctx, commands), "failed to write commands")
+	}
+
+	nodePools := make([]string, 0, len(commands))
+	for nodePool := range commands {
+		nodePools = append(nodePools, nodePool)
+	}
+	sort.Strings(nodePools)
+
+	for _, nodePool := range nodePools {
+		if _, err := fmt.Fprintf(banzaiCli.Out(), "# %s\n%s\n\n", nodePool, commands[nodePool]); err != nil {
+			return errors.WrapIf(err, "failed to write commands")
+		}
+	}
+
+	return nil
+}
+
+// getPKECommands returns the pke-installer commands of the node pools of the cluster.
+// The generated client can't decode the map of node pool names to commands, so the commands are requested directly.
+func getPKECommands(banzaiCli cli.Cli, orgID, clusterID int32) (map[string]string, error) {
+	config := banzaiCli.Client().GetConfig()
+
+	httpRequest, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/orgs/%d/clusters/%d/pke/commands", config.BasePath, orgID, clusterID), nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create PKE commands request")
+	}
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("User-Agent", config.UserAgent)
+
+	response, err := config.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to get PKE commands")
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to read PKE commands")
+	}
+	log.Debugf("PKE commands response status: %s", response.Status)
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		var pipelineError pipeline.CommonError
+		if err := json.Unmarshal(body, &pipelineError); err == nil && pipelineError.Message != "" {
+			return nil, errors.Errorf("failed to get PKE commands: %s", pipelineError.Message)
+		}
+
+		return nil, errors.Errorf("failed to get PKE commands: %s", response.Status)
+	}
+
+	var commands map[string]string
+	if err := json.Unmarshal(body, &commands); err != nil {
+		return nil, errors.WrapIf(err, "failed to unmarshal PKE commands")
+	}
+
+	return commands, nil
+}
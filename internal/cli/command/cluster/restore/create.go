@@ -0,0 +1,157 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/backup/ark"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+// createRestoreRequest is the restore create request accepted by Pipeline.
+// It differs from CreateRestoreRequest of the generated client only in its options.
+type createRestoreRequest struct {
+	BackupName string            `json:"backupName"`
+	Options    ark.BackupOptions `json:"options"`
+}
+
+type createOptions struct {
+	clustercontext.Context
+
+	includedNamespaces      []string
+	excludedNamespaces      []string
+	includedResources       []string
+	excludedResources       []string
+	includeClusterResources *bool
+
+	wait     bool
+	interval int
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai cluster restore create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [BACKUP NAME|ID]",
+		Aliases: []string{"c"},
+		Short:   "Restore a backup to a cluster",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			options.includeClusterResources = ark.ChangedBool(cmd.Flags(), "include-cluster-resources")
+
+			return runCreate(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVar(&options.includedNamespaces, "include-namespace", []string{"*"}, "Namespaces to restore")
+	flags.StringSliceVar(&options.excludedNamespaces, "exclude-namespace", nil, "Namespaces to leave out of the restore")
+	flags.StringSliceVar(&options.includedResources, "include-resource", []string{"*"}, "Resources to restore")
+	flags.StringSliceVar(&options.excludedResources, "exclude-resource", nil, "Resources to leave out of the restore")
+	flags.Bool("include-cluster-resources", true, "Restore cluster-scoped resources")
+	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for the restore to finish")
+	flags.IntVarP(&options.interval, "interval", "i", 5, "Interval in seconds for polling restore status")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "restore the backup to")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var ref string
+	if len(args) > 0 {
+		ref = args[0]
+	}
+
+	source, err := backup.GetBackup(banzaiCli, orgID, clusterID, ref)
+	if err != nil {
+		return err
+	}
+
+	request := newRestoreRequest(source.Name, options)
+
+	log.Debugf("create restore request: %#v", request)
+	var response pipeline.CreateRestoreResponse
+	if err := ark.Post(banzaiCli, ark.ClusterPath(orgID, clusterID, "restores"), request, &response); err != nil {
+		return errors.WrapIf(err, "failed to create restore")
+	}
+
+	log.Infof("restoring backup %q", source.Name)
+
+	if !options.wait {
+		format.RestoreWrite(banzaiCli, response.Restore)
+		return nil
+	}
+
+	return waitForRestore(banzaiCli, orgID, clusterID, response.Restore.Id, options.interval)
+}
+
+func newRestoreRequest(backupName string, options createOptions) createRestoreRequest {
+	return createRestoreRequest{
+		BackupName: backupName,
+		Options: ark.BackupOptions{
+			IncludedNamespaces:      options.includedNamespaces,
+			ExcludedNamespaces:      options.excludedNamespaces,
+			IncludedResources:       options.includedResources,
+			ExcludedResources:       options.excludedResources,
+			IncludeClusterResources: options.includeClusterResources,
+		},
+	}
+}
+
+func waitForRestore(banzaiCli cli.Cli, orgID, clusterID, restoreID int32, interval int) error {
+	for {
+		restore, _, err := banzaiCli.Client().ArkRestoresApi.GetARKRestore(context.Background(), orgID, clusterID, restoreID)
+		if err != nil {
+			cli.LogAPIError("get restore", err, restoreID)
+		} else if backup.IsFinished(restore.Status) { // restores go through the same phases as backups
+			format.RestoreWrite(banzaiCli, restore)
+
+			if restore.Warnings > 0 || restore.Errors > 0 {
+				log.Infof("you can check the details with the command `banzai cluster restore results --cluster %d %d`", clusterID, restoreID)
+			}
+
+			if restore.Status != backup.PhaseCompleted {
+				return errors.Errorf("restore finished with status %s", restore.Status)
+			}
+
+			return nil
+		} else {
+			log.Debugf("restore status: %s", restore.Status)
+		}
+
+		time.Sleep(time.Duration(interval) * time.Second)
+	}
+}
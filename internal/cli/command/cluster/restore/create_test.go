@@ -0,0 +1,67 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRestoreRequest(t *testing.T) {
+	no := false
+	yes := true
+
+	tests := []struct {
+		name     string
+		options  createOptions
+		contains string
+		excludes string
+	}{
+		{
+			name:     "default",
+			options:  createOptions{includedNamespaces: []string{"*"}},
+			excludes: `"includeClusterResources"`,
+		},
+		{
+			name:     "include cluster resources",
+			options:  createOptions{includeClusterResources: &yes},
+			contains: `"includeClusterResources":true`,
+		},
+		{
+			name:     "exclude cluster resources",
+			options:  createOptions{includeClusterResources: &no},
+			contains: `"includeClusterResources":false`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			body, err := json.Marshal(newRestoreRequest("nightly", test.options))
+			require.NoError(t, err)
+
+			require.Contains(t, string(body), `"backupName":"nightly"`)
+			if test.contains != "" {
+				require.Contains(t, string(body), test.contains)
+			}
+			if test.excludes != "" {
+				require.NotContains(t, string(body), test.excludes)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// getRestore gets a restore of a cluster either by its numerical ID or by its name.
+// If ref is empty, the user is asked to select one in interactive mode.
+func getRestore(banzaiCli cli.Cli, orgID, clusterID int32, ref string) (pipeline.RestoreResponse, error) {
+	client := banzaiCli.Client()
+
+	if id, err := strconv.ParseInt(ref, 10, 32); err == nil {
+		restore, _, err := client.ArkRestoresApi.GetARKRestore(context.Background(), orgID, clusterID, int32(id))
+		if err != nil {
+			cli.LogAPIError("get restore", err, id)
+			return restore, errors.WrapIff(err, "could not get restore %d", id)
+		}
+
+		return restore, nil
+	}
+
+	restores, _, err := client.ArkRestoresApi.ListARKRestores(context.Background(), orgID, clusterID)
+	if err != nil {
+		cli.LogAPIError("list restores", err, clusterID)
+		return pipeline.RestoreResponse{}, errors.WrapIf(err, "could not list restores")
+	}
+
+	if len(restores) == 0 {
+		return pipeline.RestoreResponse{}, errors.New("there are no restores on the cluster")
+	}
+
+	if ref == "" {
+		if !banzaiCli.Interactive() {
+			return pipeline.RestoreResponse{}, errors.New("no restore is selected; specify the name or ID of the restore")
+		}
+
+		names := make([]string, len(restores))
+		for i, restore := range restores {
+			names[i] = restore.Name
+		}
+
+		if err := survey.AskOne(&survey.Select{Message: "Restore:", Options: names}, &ref, survey.WithValidator(survey.Required)); err != nil {
+			return pipeline.RestoreResponse{}, errors.WrapIf(err, "failed to select a restore")
+		}
+	}
+
+	for _, restore := range restores {
+		if restore.Name == ref {
+			return restore, nil
+		}
+	}
+
+	return pipeline.RestoreResponse{}, errors.Errorf("could not find restore named %q", ref)
+}
@@ -0,0 +1,184 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const (
+	scopeArk     = "(ark)"
+	scopeCluster = "(cluster)"
+)
+
+type resultsOptions struct {
+	clustercontext.Context
+}
+
+// restoreResults is the decoded form of pipeline.RestoreResultsResponse.
+// Pipeline sends the per namespace warnings as lists of messages, just like the errors, but the generated
+// warnings model expects objects instead of lists, so the warnings are decoded with the errors model.
+type restoreResults struct {
+	Errors   pipeline.RestoreResultErrors `json:"errors"`
+	Warnings pipeline.RestoreResultErrors `json:"warnings"`
+}
+
+type resultSummary struct {
+	Namespace string
+	Warnings  int
+	Errors    int
+}
+
+// NewResultsCommand creates a new cobra.Command for `banzai cluster restore results`.
+func NewResultsCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := resultsOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "results [NAME|ID]",
+		Aliases: []string{"result", "r"},
+		Short:   "Show the warnings and errors of a restore",
+		Long:    "Show the number of warnings and errors of a restore per namespace. Use the yaml or json output format to get the messages themselves.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runResults(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "show restore results of")
+
+	return cmd
+}
+
+func runResults(banzaiCli cli.Cli, options resultsOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var ref string
+	if len(args) > 0 {
+		ref = args[0]
+	}
+
+	restore, err := getRestore(banzaiCli, orgID, clusterID, ref)
+	if err != nil {
+		return err
+	}
+
+	results, err := getRestoreResults(banzaiCli, orgID, clusterID, restore.Id)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		ctx := &output.Context{
+			Out:    banzaiCli.Out(),
+			Color:  banzaiCli.Color(),
+			Format: banzaiCli.OutputFormat(),
+		}
+
+		return output.Output(ctx, results)
+	}
+
+	format.RestoreResultsWrite(banzaiCli, summarizeResults(results))
+	return nil
+}
+
+func getRestoreResults(banzaiCli cli.Cli, orgID, clusterID, restoreID int32) (restoreResults, error) {
+	var results restoreResults
+
+	response, resp, err := banzaiCli.Client().ArkRestoresApi.GetARKRestoreResuts(context.Background(), orgID, clusterID, restoreID)
+
+	var raw []byte
+	if err != nil {
+		// the generated client keeps the response body in the error if it fails to decode a successful response
+		apiErr, ok := err.(pipeline.GenericOpenAPIError)
+		if !ok || resp == nil || resp.StatusCode != http.StatusOK {
+			cli.LogAPIError("get restore results", err, restoreID)
+			return results, errors.WrapIf(err, "could not get restore results")
+		}
+		raw = apiErr.Body()
+	} else if raw, err = json.Marshal(response); err != nil {
+		return results, errors.WrapIf(err, "failed to marshal restore results")
+	}
+
+	return results, errors.WrapIf(json.Unmarshal(raw, &results), "failed to parse restore results")
+}
+
+// summarizeResults counts the warnings and errors of a restore per namespace
+func summarizeResults(results restoreResults) []resultSummary {
+	summaries := make(map[string]*resultSummary)
+	summary := func(namespace string) *resultSummary {
+		if _, ok := summaries[namespace]; !ok {
+			summaries[namespace] = &resultSummary{Namespace: namespace}
+		}
+		return summaries[namespace]
+	}
+
+	for namespace, count := range countMessages(results.Warnings) {
+		summary(namespace).Warnings += count
+	}
+	for namespace, count := range countMessages(results.Errors) {
+		summary(namespace).Errors += count
+	}
+
+	rows := make([]resultSummary, 0, len(summaries))
+	for _, s := range summaries {
+		rows = append(rows, *s)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Namespace < rows[j].Namespace
+	})
+
+	return rows
+}
+
+func countMessages(messages pipeline.RestoreResultErrors) map[string]int {
+	counts := make(map[string]int)
+	if len(messages.Ark) > 0 {
+		counts[scopeArk] = len(messages.Ark)
+	}
+	if len(messages.Cluster) > 0 {
+		counts[scopeCluster] = len(messages.Cluster)
+	}
+
+	for _, namespaces := range messages.Namespaces {
+		for namespace, list := range namespaces {
+			if list, ok := list.([]interface{}); ok {
+				counts[namespace] += len(list)
+			} else {
+				counts[namespace]++
+			}
+		}
+	}
+
+	return counts
+}
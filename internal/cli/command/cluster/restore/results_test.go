@@ -0,0 +1,65 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestSummarizeResults(t *testing.T) {
+	// the shape of the response of Pipeline, see the RestoreResultsResponse example of its API description
+	raw := `{
+		"warnings": {
+			"ark": [],
+			"cluster": ["could not restore, persistentvolumes \"pv-1\" already exists"],
+			"namespaces": [
+				{"default": [
+					"not restored: services \"kubernetes\" already exists and is different from backed up version.",
+					"not restored: services \"pipeline-traefik\" already exists and is different from backed up version."
+				]},
+				{"pipeline-system": ["not restored: configmaps \"pipeline\" already exists and is different from backed up version."]}
+			]
+		},
+		"errors": {
+			"ark": ["timed out waiting for all resources to be restored"],
+			"cluster": [],
+			"namespaces": [
+				{"default": ["error restoring pods/default/app: the server could not find the requested resource"]}
+			]
+		}
+	}`
+
+	// the generated model fails on the per namespace warnings
+	require.Error(t, json.Unmarshal([]byte(raw), &pipeline.RestoreResultsResponse{}))
+
+	var results restoreResults
+	require.NoError(t, json.Unmarshal([]byte(raw), &results))
+
+	require.Equal(t, []resultSummary{
+		{Namespace: scopeArk, Warnings: 0, Errors: 1},
+		{Namespace: scopeCluster, Warnings: 1, Errors: 0},
+		{Namespace: "default", Warnings: 2, Errors: 1},
+		{Namespace: "pipeline-system", Warnings: 1, Errors: 0},
+	}, summarizeResults(results))
+}
+
+func TestSummarizeResultsEmpty(t *testing.T) {
+	require.Empty(t, summarizeResults(restoreResults{}))
+}
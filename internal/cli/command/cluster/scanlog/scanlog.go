@@ -0,0 +1,147 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanlog
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+const actionReject = "reject"
+
+type scanLogOptions struct {
+	clustercontext.Context
+
+	failOn string
+}
+
+type scanLogRow struct {
+	ReleaseName string
+	Resource    string
+	Images      string
+	Action      string
+	Severity    string
+	Result      string
+}
+
+// NewScanLogCommand creates a new cobra.Command for `banzai cluster scanlog`.
+func NewScanLogCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := scanLogOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "scanlog [RELEASE_NAME]",
+		Aliases: []string{"scanlogs", "scans"},
+		Short:   "List image scan results of a cluster",
+		Long: "List the verdicts of the admission image scans of a cluster, or of a single release of it. " +
+			"With --fail-on the command exits with a non-zero code if any result is at least as severe as the given threshold (" + strings.Join(thresholds(), ", ") + ").",
+		Example: `
+		$ banzai cluster scanlog my-release --cluster-name mycluster --fail-on high`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runScanLog(banzaiCli, options, args)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.failOn, "fail-on", "", "Exit with a non-zero code if any result reaches this severity")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "list image scan results of")
+
+	return cmd
+}
+
+func runScanLog(banzaiCli cli.Cli, options scanLogOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	threshold := severityNone
+	if options.failOn != "" {
+		var ok bool
+		if threshold, ok = parseThreshold(options.failOn); !ok {
+			return errors.Errorf("invalid --fail-on value %q, valid values are: %s", options.failOn, strings.Join(thresholds(), ", "))
+		}
+	}
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	var (
+		scans []pipeline.ScanLogItem
+		err   error
+	)
+	if len(args) > 0 {
+		scans, _, err = client.ScanlogApi.ListScansByRelease(context.Background(), orgID, clusterID, args[0])
+	} else {
+		scans, _, err = client.ScanlogApi.ListScans(context.Background(), orgID, clusterID)
+	}
+	if err != nil {
+		cli.LogAPIError("list scan logs", err, clusterID)
+		return errors.WrapIf(err, "could not list image scan results")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.ScanLogsWrite(banzaiCli, scans)
+	} else {
+		rows := make([]scanLogRow, len(scans))
+		for i, scan := range scans {
+			images := make([]string, len(scan.Image))
+			for j, image := range scan.Image {
+				images[j] = image.ImageName
+				if image.ImageTag != "" {
+					images[j] += ":" + image.ImageTag
+				}
+			}
+
+			rows[i] = scanLogRow{
+				ReleaseName: scan.ReleaseName,
+				Resource:    scan.Resource,
+				Images:      strings.Join(images, ","),
+				Action:      scan.Action,
+				Severity:    scanSeverity(scan).String(),
+				Result:      strings.Join(scan.Result, "; "),
+			}
+		}
+
+		format.ScanLogsWrite(banzaiCli, rows)
+	}
+
+	if threshold == severityNone {
+		return nil
+	}
+
+	var failed int
+	for _, scan := range scans {
+		if reaches(scan, threshold) {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("%d scan result(s) reached the %q threshold", failed, options.failOn)
+	}
+
+	return nil
+}
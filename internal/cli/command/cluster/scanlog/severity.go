@@ -0,0 +1,97 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanlog
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+// severity is the severity of an image scan result, in increasing order.
+type severity int
+
+const (
+	severityNone severity = iota
+	severityNegligible
+	severityLow
+	severityMedium
+	severityHigh
+	severityCritical
+
+	// severityRejected is a threshold that is reached by scans rejected by the admission policy, regardless of their severity.
+	severityRejected
+)
+
+var severityNames = map[severity]string{
+	severityNegligible: "negligible",
+	severityLow:        "low",
+	severityMedium:     "medium",
+	severityHigh:       "high",
+	severityCritical:   "critical",
+	severityRejected:   "rejected",
+}
+
+func (s severity) String() string {
+	return severityNames[s]
+}
+
+// thresholds returns the valid values of the --fail-on flag.
+func thresholds() []string {
+	names := make([]string, 0, len(severityNames))
+	for s := severityNegligible; s <= severityRejected; s++ {
+		names = append(names, s.String())
+	}
+
+	return names
+}
+
+func parseThreshold(name string) (severity, bool) {
+	for s, n := range severityNames {
+		if strings.EqualFold(name, n) {
+			return s, true
+		}
+	}
+
+	return severityNone, false
+}
+
+// scanSeverity returns the highest severity of the results of a scan.
+// Only the leading severity token of the results is taken into account, like in "HIGH Vulnerability found in ...",
+// as the rest of the text may mention severities without reporting them.
+func scanSeverity(scan pipeline.ScanLogItem) severity {
+	highest := severityNone
+	for _, result := range scan.Result {
+		fields := strings.Fields(result)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if s, ok := parseThreshold(fields[0]); ok && s != severityRejected && s > highest {
+			highest = s
+		}
+	}
+
+	return highest
+}
+
+// reaches tells whether a scan reaches the given threshold.
+func reaches(scan pipeline.ScanLogItem, threshold severity) bool {
+	if threshold == severityRejected {
+		return strings.EqualFold(scan.Action, actionReject)
+	}
+
+	return scanSeverity(scan) >= threshold
+}
@@ -0,0 +1,68 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestReaches(t *testing.T) {
+	highScan := pipeline.ScanLogItem{
+		Action: "reject",
+		Result: []string{
+			"MEDIUM Vulnerability found in os package type (dpkg) - libgcrypt20 (CVE-2019-13627)",
+			"HIGH Vulnerability found in os package type (dpkg) - libssl1.1 (CVE-2019-1543)",
+		},
+	}
+	cleanScan := pipeline.ScanLogItem{
+		Action: "allow",
+		Result: []string{"Image passed policy evaluation"},
+	}
+	mentionScan := pipeline.ScanLogItem{
+		Action: "allow",
+		Result: []string{"No high or critical vulnerabilities found", "Image passed policy evaluation"},
+	}
+	rootScan := pipeline.ScanLogItem{
+		Action: "reject",
+		Result: []string{"User root found as effective user, which is explicitly not allowed"},
+	}
+
+	tests := []struct {
+		name      string
+		scan      pipeline.ScanLogItem
+		threshold severity
+		expected  bool
+	}{
+		{name: "high reaches medium", scan: highScan, threshold: severityMedium, expected: true},
+		{name: "high reaches high", scan: highScan, threshold: severityHigh, expected: true},
+		{name: "high does not reach critical", scan: highScan, threshold: severityCritical, expected: false},
+		{name: "clean does not reach negligible", scan: cleanScan, threshold: severityNegligible, expected: false},
+		{name: "mentioned severity does not reach low", scan: mentionScan, threshold: severityLow, expected: false},
+		{name: "rejected reaches rejected", scan: rootScan, threshold: severityRejected, expected: true},
+		{name: "allowed does not reach rejected", scan: cleanScan, threshold: severityRejected, expected: false},
+		{name: "rejected without severity does not reach low", scan: rootScan, threshold: severityLow, expected: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, reaches(test.scan, test.threshold))
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+const (
+	clusterStatusRunning  = "RUNNING"
+	clusterStatusUpdating = "UPDATING"
+
+	// updateStartGracePeriod is how long the cluster is waited for to become UPDATING
+	updateStartGracePeriod = 30 * time.Second
+)
+
+type updateOptions struct {
+	clustercontext.Context
+
+	file     string
+	wait     bool
+	interval int
+}
+
+// NewUpdateCommand creates a new cobra.Command for `banzai cluster update`.
+func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update [--cluster=ID | [--cluster-name=]NAME]",
+		Aliases: []string{"u", "up"},
+		Short:   "Update a cluster",
+		Long:    "Update a cluster based on a JSON or YAML update request. In case of interactive mode banzai CLI opens the current node pool layout of the cluster in a text editor.",
+		Example: `
+		$ banzai cluster update mycluster -f spec.yaml --wait
+		$ banzai cluster update --cluster-name mycluster`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runUpdate(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Cluster update request file")
+	flags.BoolVarP(&options.wait, "wait", "w", false, "Wait for cluster update")
+	flags.IntVarP(&options.interval, "interval", "i", 10, "Interval in seconds for polling cluster status")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "update")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(args...); err != nil {
+		return err
+	}
+	clusterID := options.ClusterID()
+
+	out := map[string]interface{}{}
+
+	if banzaiCli.Interactive() && options.file == "" {
+		cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, clusterID)
+		if err != nil {
+			cli.LogAPIError("get cluster", err, clusterID)
+			return errors.WrapIf(err, "failed to get cluster details")
+		}
+
+		if err := buildInteractiveUpdateRequest(cluster, out); err != nil {
+			return err
+		}
+	} else {
+		filename, raw, err := utils.ReadFileOrStdin(options.file)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		log.Debugf("%d bytes read", len(raw))
+
+		if err := utils.Unmarshal(raw, &out); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal update cluster request")
+		}
+
+		// validate the request in JSON form, so that YAML descriptors are accepted as well
+		if raw, err = json.Marshal(out); err != nil {
+			return errors.WrapIf(err, "failed to marshal update cluster request")
+		}
+
+		if err := validateClusterUpdateRequest(raw); err != nil {
+			return errors.WrapIf(err, "failed to parse update cluster request")
+		}
+	}
+
+	log.Debugf("update request: %#v", out)
+	if _, err := client.ClustersApi.UpdateCluster(context.Background(), orgID, clusterID, out); err != nil {
+		cli.LogAPIError("update cluster", err, out)
+		return errors.WrapIf(utils.ConvertError(err), "failed to update cluster")
+	}
+
+	log.Info("cluster is being updated")
+	if !options.wait {
+		log.Infof("you can check its status with the command `banzai cluster get %q`", options.ClusterName())
+		return nil
+	}
+
+	// the cluster may not be UPDATING right after the request is accepted, so its status is only
+	// taken as final once it has been UPDATING, or after a grace period
+	started := time.Now()
+	updating := false
+	for {
+		cluster, _, err := client.ClustersApi.GetCluster(context.Background(), orgID, clusterID)
+		if err != nil {
+			cli.LogAPIError("get cluster", err, clusterID)
+		} else {
+			format.ClusterShortWrite(banzaiCli, cluster)
+
+			switch {
+			case cluster.Status == clusterStatusUpdating:
+				updating = true
+			case updating || time.Since(started) > updateStartGracePeriod:
+				if cluster.Status != clusterStatusRunning {
+					return errors.Errorf("cluster update finished with status %s: %s", cluster.Status, cluster.StatusMessage)
+				}
+
+				return nil
+			}
+		}
+
+		time.Sleep(time.Duration(options.interval) * time.Second)
+	}
+}
+
+// validateClusterUpdateRequest checks that the request is a valid UpdateClusterRequest,
+// or an UpdateClusterRequestV2 if it has no cloud field.
+func validateClusterUpdateRequest(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		if bytes, ok := val.([]byte); ok {
+			str = string(bytes)
+		} else {
+			return errors.New("value is not a string or []byte")
+		}
+	}
+
+	var typer struct{ Cloud string }
+	if err := json.NewDecoder(strings.NewReader(str)).Decode(&typer); err != nil {
+		return errors.WrapIf(err, "invalid JSON request")
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(str))
+	decoder.DisallowUnknownFields()
+
+	if typer.Cloud == "" {
+		var request pipeline.UpdateClusterRequestV2
+		if err := decoder.Decode(&request); err != nil {
+			return errors.WrapIf(err, "invalid request")
+		}
+
+		if len(request.Nodepools) == 0 {
+			return errors.New("invalid request: either cloud and properties, or nodepools must be specified")
+		}
+
+		return nil
+	}
+
+	var request pipeline.UpdateClusterRequest
+	if err := decoder.Decode(&request); err != nil {
+		return errors.WrapIf(err, "invalid request")
+	}
+
+	if len(request.Properties) == 0 {
+		return errors.New("invalid request: properties must be specified")
+	}
+
+	return nil
+}
+
+// buildInteractiveUpdateRequest lets the user edit an update request built from the current node pools of the cluster.
+func buildInteractiveUpdateRequest(cluster pipeline.GetClusterStatusResponse, out map[string]interface{}) error {
+	nodePools := make(map[string]interface{}, len(cluster.NodePools))
+	for name, nodePool := range cluster.NodePools {
+		nodePools[name] = map[string]interface{}{
+			"instanceType": nodePool.InstanceType,
+			"spotPrice":    nodePool.SpotPrice,
+			"autoscaling":  nodePool.Autoscaling,
+			"count":        nodePool.Count,
+			"minCount":     nodePool.MinCount,
+			"maxCount":     nodePool.MaxCount,
+			"labels":       nodePool.Labels,
+		}
+	}
+
+	out["cloud"] = cluster.Cloud
+	out["properties"] = map[string]interface{}{
+		cluster.Distribution: map[string]interface{}{
+			"nodePools": nodePools,
+		},
+	}
+
+	bytes, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return errors.WrapIf(err, "failed to marshal request")
+	}
+	content := string(bytes)
+
+	if err := survey.AskOne(
+		&survey.Editor{
+			Message:       "Update cluster request:",
+			Default:       content,
+			HideDefault:   true,
+			AppendDefault: true,
+		},
+		&content,
+		survey.WithValidator(validateClusterUpdateRequest),
+	); err != nil {
+		return errors.WrapIf(err, "failed to edit update cluster request")
+	}
+
+	for key := range out {
+		delete(out, key)
+	}
+	if err := json.Unmarshal([]byte(content), &out); err != nil {
+		return errors.WrapIf(err, "failed to parse update cluster request")
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "The update request:\n\n%s\n", content)
+
+	var update bool
+	_ = survey.AskOne(
+		&survey.Confirm{
+			Message: fmt.Sprintf("Do you want to UPDATE the cluster %q now?", cluster.Name),
+		},
+		&update,
+	)
+
+	if !update {
+		return errors.New("cluster update cancelled")
+	}
+
+	return nil
+}
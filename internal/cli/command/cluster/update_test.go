@@ -0,0 +1,85 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateClusterUpdateRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request interface{}
+		valid   bool
+	}{
+		{
+			name:    "v1",
+			request: `{"cloud": "amazon", "properties": {"eks": {"nodePools": {"pool1": {"count": 3}}}}}`,
+			valid:   true,
+		},
+		{
+			name:    "v1 as bytes",
+			request: []byte(`{"cloud": "google", "properties": {"gke": {"nodePools": {}}}}`),
+			valid:   true,
+		},
+		{
+			name:    "v1 without properties",
+			request: `{"cloud": "amazon"}`,
+		},
+		{
+			name:    "v1 with unknown field",
+			request: `{"cloud": "amazon", "properties": {"eks": {}}, "nodePools": {}}`,
+		},
+		{
+			name:    "v2",
+			request: `{"nodepools": [{"name": "pool1", "roles": ["worker"], "count": 3, "instanceType": "Standard_B2s"}]}`,
+			valid:   true,
+		},
+		{
+			name:    "v2 without node pools",
+			request: `{"nodepools": []}`,
+		},
+		{
+			name:    "v2 with unknown field",
+			request: `{"nodepools": [{"name": "pool1", "instanceType": "Standard_B2s"}], "properties": {}}`,
+		},
+		{
+			name:    "v2 with unknown node pool field",
+			request: `{"nodepools": [{"name": "pool1", "instanceType": "Standard_B2s", "spotPrice": "0.1"}]}`,
+		},
+		{
+			name:    "invalid JSON",
+			request: `{"cloud": `,
+		},
+		{
+			name:    "not a string",
+			request: 42,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := validateClusterUpdateRequest(test.request)
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
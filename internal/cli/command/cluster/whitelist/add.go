@@ -0,0 +1,107 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package whitelist
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/integratedservice/services/securityscan"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type addOptions struct {
+	clustercontext.Context
+
+	owner  string
+	reason string
+}
+
+// NewAddCommand creates a new cobra.Command for `banzai cluster whitelist add`.
+func NewAddCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := addOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add RELEASE_NAME",
+		Aliases: []string{"a", "create"},
+		Short:   "Exempt a release from security scan admission",
+		Long:    "Exempt a release of a cluster from security scan admission. The owner of the entry defaults to the current user.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runAdd(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.owner, "owner", "", "Owner of the whitelist entry")
+	flags.StringVar(&options.reason, "reason", "", "Reason of exempting the release")
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "whitelist release of")
+
+	return cmd
+}
+
+func runAdd(banzaiCli cli.Cli, options addOptions, releaseName string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	if options.reason == "" && banzaiCli.Interactive() {
+		if err := survey.AskOne(&survey.Input{Message: "Reason:"}, &options.reason, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get reason")
+		}
+	}
+
+	if err := securityscan.ValidateWhitelistedRelease(releaseName, options.reason); err != nil {
+		return err
+	}
+
+	if options.owner == "" {
+		user, _, err := client.UsersApi.GetCurrentUser(context.Background())
+		if err != nil {
+			cli.LogAPIError("get current user", err, nil)
+			return errors.WrapIf(err, "could not get current user, specify the owner with --owner")
+		}
+		options.owner = user.Login
+	}
+
+	item := pipeline.ReleaseWhiteListItem{
+		Name:   releaseName,
+		Owner:  options.owner,
+		Reason: options.reason,
+	}
+
+	log.Debugf("create whitelist request: %#v", item)
+	if _, err := client.WhitelistApi.CreateWhitelists(context.Background(), orgID, options.ClusterID(), item); err != nil {
+		cli.LogAPIError("whitelist release", err, item)
+		return errors.WrapIf(utils.ConvertError(err), "failed to whitelist release")
+	}
+
+	log.Infof("release %q whitelisted", releaseName)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package whitelist
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustercontext "github.com/banzaicloud/banzai-cli/internal/cli/command/cluster/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type removeOptions struct {
+	clustercontext.Context
+}
+
+// NewRemoveCommand creates a new cobra.Command for `banzai cluster whitelist remove`.
+func NewRemoveCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := removeOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "remove RELEASE_NAME",
+		Aliases: []string{"rm", "delete", "d"},
+		Short:   "Remove a release from the security scan whitelist",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runRemove(banzaiCli, options, args[0])
+		},
+	}
+
+	options.Context = clustercontext.NewClusterContext(cmd, banzaiCli, "remove whitelisted release of")
+
+	return cmd
+}
+
+func runRemove(banzaiCli cli.Cli, options removeOptions, releaseName string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to REMOVE the release from the whitelist?"}, &confirmed)
+		if !confirmed {
+			return errors.New("removal cancelled")
+		}
+	}
+
+	if _, err := banzaiCli.Client().WhitelistApi.DeleteWhitelist(context.Background(), orgID, options.ClusterID(), releaseName); err != nil {
+		cli.LogAPIError("remove whitelisted release", err, releaseName)
+		return errors.WrapIf(utils.ConvertError(err), "failed to remove release from whitelist")
+	}
+
+	log.Infof("release %q removed from whitelist", releaseName)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/deployment"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/feature"
+)
+
+// NewClusterGroupCommand returns a cobra command for `clustergroup` subcommands.
+func NewClusterGroupCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clustergroup",
+		Aliases: []string{"clustergroups", "cg"},
+		Short:   "Manage cluster groups",
+	}
+
+	cmd.AddCommand(
+		NewCreateCommand(banzaiCli),
+		NewDeleteCommand(banzaiCli),
+		NewGetCommand(banzaiCli),
+		NewListCommand(banzaiCli),
+		NewUpdateCommand(banzaiCli),
+		deployment.NewDeploymentCommand(banzaiCli),
+		feature.NewFeatureCommand(banzaiCli),
+	)
+
+	return cmd
+}
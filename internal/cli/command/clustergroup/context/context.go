@@ -0,0 +1,115 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroupcontext
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+type Context interface {
+	Init(...string) error
+	ClusterGroupID() int32
+	ClusterGroupName() string
+}
+
+type clusterGroupContext struct {
+	id        int32
+	name      string
+	banzaiCli cli.Cli
+}
+
+func NewClusterGroupContext(cmd *cobra.Command, banzaiCli cli.Cli, verb string) Context {
+
+	ctx := clusterGroupContext{
+		banzaiCli: banzaiCli,
+	}
+	flags := cmd.Flags()
+
+	flags.Int32Var(&ctx.id, "clustergroup", 0, fmt.Sprintf("ID of cluster group to %s", verb))
+	flags.StringVar(&ctx.name, "clustergroup-name", "", fmt.Sprintf("Name of cluster group to %s", verb))
+
+	return &ctx
+}
+
+func (c *clusterGroupContext) ClusterGroupID() int32 {
+	return c.id
+}
+
+func (c *clusterGroupContext) ClusterGroupName() string {
+	return c.name
+}
+
+// Init completes the cluster group context from the options, and if possible from the user
+func (c *clusterGroupContext) Init(args ...string) error {
+	pipeline := c.banzaiCli.Client()
+	orgId := c.banzaiCli.Context().OrganizationID()
+
+	switch len(args) {
+	case 0:
+	case 1:
+		c.name = args[0]
+	default:
+		return errors.New("invalid number of arguments")
+	}
+
+	if c.id != 0 {
+		clusterGroup, _, err := pipeline.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdGet(context.Background(), orgId, c.id)
+		if err != nil {
+			return errors.WrapIff(err, "failed to retrieve cluster group %d", c.id)
+		}
+
+		c.name = clusterGroup.Name
+		return nil
+	}
+
+	clusterGroups, _, err := pipeline.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsGet(context.Background(), orgId)
+	if err != nil {
+		return errors.WrapIf(err, "could not list cluster groups")
+	}
+
+	if len(clusterGroups) == 0 {
+		return errors.New("there are no cluster groups in the organization")
+	}
+
+	if c.name == "" {
+		if !c.banzaiCli.Interactive() {
+			return errors.New("no cluster group is selected; use the --clustergroup or --clustergroup-name option")
+		}
+
+		clusterGroupSlice := make([]string, len(clusterGroups))
+		for i, clusterGroup := range clusterGroups {
+			clusterGroupSlice[i] = clusterGroup.Name
+		}
+
+		err := survey.AskOne(&survey.Select{Message: "Cluster group:", Options: clusterGroupSlice}, &c.name, survey.WithValidator(survey.Required))
+		if err != nil {
+			return errors.WrapIf(err, "failed to select a cluster group")
+		}
+	}
+
+	for _, clusterGroup := range clusterGroups {
+		if c.name == clusterGroup.Name {
+			c.id = clusterGroup.Id
+			return nil
+		}
+	}
+	return errors.Errorf("could not find cluster group named %q", c.name)
+}
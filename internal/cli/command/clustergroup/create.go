@@ -0,0 +1,115 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type createOptions struct {
+	file    string
+	members []string
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai clustergroup create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [NAME]",
+		Aliases: []string{"c"},
+		Short:   "Create a cluster group",
+		Long:    "Create a cluster group from the given member clusters. The request can be given as a YAML/JSON file as well. In case of interactive mode banzai CLI will prompt for the members.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runCreate(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Cluster group descriptor file")
+	flags.StringSliceVarP(&options.members, "member", "m", nil, "Name or ID of a member cluster (can be repeated)")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var request pipeline.ApiCreateRequest
+	if options.file != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.file)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal input")
+		}
+	}
+
+	if len(args) > 0 {
+		request.Name = args[0]
+	}
+
+	if request.Name == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("NAME argument must be specified")
+		}
+
+		if err := survey.AskOne(&survey.Input{Message: "Cluster group name:"}, &request.Name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get cluster group name")
+		}
+	}
+
+	if len(options.members) > 0 || len(request.Members) == 0 {
+		clusters, err := listClusters(banzaiCli, orgID)
+		if err != nil {
+			return err
+		}
+
+		if len(options.members) > 0 {
+			request.Members, err = resolveMembers(clusters, options.members)
+		} else if banzaiCli.Interactive() {
+			request.Members, err = askMembers(clusters, nil)
+		} else {
+			err = errors.New("at least one member cluster must be specified with --member")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debugf("create cluster group request: %#v", request)
+	response, _, err := banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsPost(context.Background(), orgID, request)
+	if err != nil {
+		cli.LogAPIError("create cluster group", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to create cluster group")
+	}
+
+	log.Infof("cluster group %q (ID %d) created", response.Name, response.Id)
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type deleteOptions struct {
+	clustergroupcontext.Context
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai clustergroup delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [NAME]",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete a cluster group",
+		Long:    "Delete a cluster group. The member clusters are not deleted. In case of interactive mode banzai CLI will prompt for a confirmation.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "delete")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(args...); err != nil {
+		return err
+	}
+	clusterGroupID := options.ClusterGroupID()
+
+	if banzaiCli.Interactive() {
+		clusterGroup, _, err := client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdGet(context.Background(), orgID, clusterGroupID)
+		if err != nil {
+			cli.LogAPIError("get cluster group", err, clusterGroupID)
+			return errors.WrapIf(err, "could not get cluster group")
+		}
+
+		format.ClusterGroupWrite(banzaiCli, clusterGroup)
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the cluster group?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, _, err := client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDelete(context.Background(), orgID, clusterGroupID); err != nil {
+		cli.LogAPIError("delete cluster group", err, clusterGroupID)
+		return errors.WrapIf(utils.ConvertError(err), "failed to delete cluster group")
+	}
+
+	log.Infof("cluster group %q deleted", options.ClusterGroupName())
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type createOptions struct {
+	clustergroupcontext.Context
+	requestOptions
+
+	releaseName string
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai clustergroup deployment create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [CHART]",
+		Aliases: []string{"c"},
+		Short:   "Deploy a Helm chart to the members of a cluster group",
+		Long:    "Deploy a Helm chart to the members of a cluster group. Values can be given for every target cluster with --values, and for a single target cluster with --cluster-values. The request can be given as a YAML/JSON file as well.",
+		Example: `
+		$ banzai clustergroup deployment create stable/nginx-ingress --clustergroup-name edge --release-name ingress \
+			--values values.yaml --cluster-values edge-eu=values-eu.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runCreate(banzaiCli, options, cmd, args)
+		},
+	}
+
+	options.addFlags(cmd)
+	cmd.Flags().StringVarP(&options.releaseName, "release-name", "r", "", "Name of the release (generated if not given)")
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "deploy to")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, cmd *cobra.Command, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	request, err := options.buildRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		request.Name = args[0]
+	}
+	if options.releaseName != "" {
+		request.ReleaseName = options.releaseName
+	}
+
+	if request.Name == "" {
+		if !banzaiCli.Interactive() {
+			return errors.New("CHART argument must be specified")
+		}
+
+		if err := survey.AskOne(&survey.Input{Message: "Chart:"}, &request.Name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get chart name")
+		}
+	}
+
+	log.Debugf("create cluster group deployment request: %#v", request)
+	response, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsPost(context.Background(), orgID, options.ClusterGroupID(), request)
+	if err != nil {
+		cli.LogAPIError("create cluster group deployment", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to create deployment")
+	}
+
+	log.Infof("deployment %q is being created in cluster group %q", response.ReleaseName, options.ClusterGroupName())
+	format.ClusterGroupDeploymentTargetsWrite(banzaiCli, response.TargetClusters)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/antihax/optional"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type deleteOptions struct {
+	clustergroupcontext.Context
+
+	force bool
+}
+
+// NewDeleteCommand creates a new cobra.Command for `banzai clustergroup deployment delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "delete [RELEASE_NAME]",
+		Aliases: []string{"d", "rm"},
+		Short:   "Delete a deployment of a cluster group",
+		Long:    "Delete a deployment of a cluster group from all of its target clusters.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDelete(banzaiCli, options, args)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.force, "force", false, "Delete the deployment even if it can not be removed from some of the target clusters")
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "delete deployment of")
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, options deleteOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterGroupID := options.ClusterGroupID()
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterGroupID, releaseName)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		deployment, _, err := client.ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameGet(context.Background(), orgID, clusterGroupID, releaseName)
+		if err != nil {
+			cli.LogAPIError("get cluster group deployment", err, releaseName)
+			return errors.WrapIff(err, "could not get deployment %q", releaseName)
+		}
+
+		format.ClusterGroupDeploymentWrite(banzaiCli, deployment)
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DELETE the deployment from every target cluster?"}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	opts := &pipeline.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameDeleteOpts{
+		Force: optional.NewBool(options.force),
+	}
+	status, _, err := client.ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameDelete(context.Background(), orgID, clusterGroupID, releaseName, opts)
+	if err != nil {
+		cli.LogAPIError("delete cluster group deployment", err, releaseName)
+		return errors.WrapIf(utils.ConvertError(err), "failed to delete deployment")
+	}
+
+	log.Infof("deployment %q is being deleted", releaseName)
+	format.ClusterGroupDeploymentTargetsWrite(banzaiCli, []interface{}{status})
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// requestOptions are the options shared by the create and update commands.
+type requestOptions struct {
+	file          string
+	version       string
+	namespace     string
+	valuesFiles   []string
+	clusterValues []string
+	atomic        bool
+	dryRun        bool
+	rollingMode   bool
+}
+
+func (o *requestOptions) addFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVarP(&o.file, "file", "f", "", "Deployment descriptor file")
+	flags.StringVar(&o.version, "version", "", "Version of the chart to deploy")
+	flags.StringVarP(&o.namespace, "namespace", "n", "", "Namespace to deploy the release to")
+	flags.StringSliceVar(&o.valuesFiles, "values", nil, "Helm values file applied on every target cluster (can be repeated)")
+	flags.StringSliceVar(&o.clusterValues, "cluster-values", nil, "Helm values file applied on a single target cluster in CLUSTER=FILE format (can be repeated)")
+	flags.BoolVar(&o.atomic, "atomic", false, "Roll back the release on the target clusters in case of a failure")
+	flags.BoolVar(&o.dryRun, "dry-run", false, "Simulate the deployment")
+	flags.BoolVar(&o.rollingMode, "rolling", false, "Deploy to the target clusters one by one")
+}
+
+// buildRequest assembles a deployment request from the descriptor file and the flags.
+func (o *requestOptions) buildRequest(cmd *cobra.Command) (pipeline.DeploymentClusterGroupDeployment, error) {
+	var request pipeline.DeploymentClusterGroupDeployment
+
+	if o.file != "" {
+		filename, raw, err := utils.ReadFileOrStdin(o.file)
+		if err != nil {
+			return request, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return request, errors.WrapIf(err, "failed to unmarshal input")
+		}
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("version") {
+		request.Version = o.version
+	}
+	if flags.Changed("namespace") {
+		request.Namespace = o.namespace
+	}
+	if flags.Changed("atomic") {
+		request.Atomic = o.atomic
+	}
+	if flags.Changed("dry-run") {
+		request.Dryrun = o.dryRun
+	}
+	if flags.Changed("rolling") {
+		request.RollingMode = o.rollingMode
+	}
+
+	if len(o.valuesFiles) > 0 {
+		values, err := utils.ReadValuesFiles(o.valuesFiles)
+		if err != nil {
+			return request, err
+		}
+
+		if request.Values == nil {
+			request.Values = map[string]interface{}{}
+		}
+		request.Values = utils.MergeValues(request.Values, values)
+	}
+
+	overrides, err := readClusterValues(o.clusterValues)
+	if err != nil {
+		return request, err
+	}
+	for clusterName, values := range overrides {
+		if request.ValueOverrides == nil {
+			request.ValueOverrides = map[string]interface{}{}
+		}
+
+		current, _ := request.ValueOverrides[clusterName].(map[string]interface{})
+		if current == nil {
+			current = map[string]interface{}{}
+		}
+		request.ValueOverrides[clusterName] = utils.MergeValues(current, values)
+	}
+
+	return request, nil
+}
+
+// readClusterValues reads per-cluster values files given in CLUSTER=FILE format.
+func readClusterValues(specs []string) (map[string]map[string]interface{}, error) {
+	filesByCluster := make(map[string][]string)
+	var clusterNames []string
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid cluster values %q, expected CLUSTER=FILE format", spec)
+		}
+
+		if _, ok := filesByCluster[parts[0]]; !ok {
+			clusterNames = append(clusterNames, parts[0])
+		}
+		filesByCluster[parts[0]] = append(filesByCluster[parts[0]], parts[1])
+	}
+
+	result := make(map[string]map[string]interface{}, len(clusterNames))
+	for _, clusterName := range clusterNames {
+		values, err := utils.ReadValuesFiles(filesByCluster[clusterName])
+		if err != nil {
+			return nil, errors.WrapIff(err, "failed to read values of cluster %q", clusterName)
+		}
+		result[clusterName] = values
+	}
+
+	return result, nil
+}
+
+// getReleaseName returns the given release name, or asks the user to select one of the deployments of the cluster group in interactive mode.
+func getReleaseName(banzaiCli cli.Cli, orgID, clusterGroupID int32, releaseName string) (string, error) {
+	if releaseName != "" {
+		return releaseName, nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return "", errors.New("no deployment is selected; specify the release name of the deployment")
+	}
+
+	deployments, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsGet(context.Background(), orgID, clusterGroupID)
+	if err != nil {
+		cli.LogAPIError("list cluster group deployments", err, clusterGroupID)
+		return "", errors.WrapIf(err, "could not list deployments")
+	}
+
+	if len(deployments) == 0 {
+		return "", errors.New("there are no deployments in the cluster group")
+	}
+
+	names := make([]string, len(deployments))
+	for i, deployment := range deployments {
+		names[i] = deployment.ReleaseName
+	}
+
+	if err := survey.AskOne(&survey.Select{Message: "Deployment:", Options: names}, &releaseName, survey.WithValidator(survey.Required)); err != nil {
+		return "", errors.WrapIf(err, "failed to select a deployment")
+	}
+
+	return releaseName, nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type getOptions struct {
+	clustergroupcontext.Context
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai clustergroup deployment get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get [RELEASE_NAME]",
+		Aliases: []string{"g", "show"},
+		Short:   "Get details of a deployment of a cluster group",
+		Long:    "Get details of a deployment of a cluster group, including its status on each target cluster.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runGet(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "get deployment of")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, options.ClusterGroupID(), releaseName)
+	if err != nil {
+		return err
+	}
+
+	deployment, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameGet(context.Background(), orgID, options.ClusterGroupID(), releaseName)
+	if err != nil {
+		cli.LogAPIError("get cluster group deployment", err, releaseName)
+		return errors.WrapIff(err, "could not get deployment %q", releaseName)
+	}
+
+	format.ClusterGroupDeploymentWrite(banzaiCli, deployment)
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault && len(deployment.TargetClusters) > 0 {
+		fmt.Fprintln(banzaiCli.Out())
+		format.ClusterGroupDeploymentTargetsWrite(banzaiCli, deployment.TargetClusters)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type syncOptions struct {
+	clustergroupcontext.Context
+}
+
+// NewSyncCommand creates a new cobra.Command for `banzai clustergroup deployment sync`.
+func NewSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := syncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync [RELEASE_NAME]",
+		Short: "Synchronize a deployment to the members of a cluster group",
+		Long:  "Synchronize a deployment to the members of a cluster group: install it on new members, and remove it from the clusters that are no longer members.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runSync(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "synchronize")
+
+	return cmd
+}
+
+func runSync(banzaiCli cli.Cli, options syncOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, options.ClusterGroupID(), releaseName)
+	if err != nil {
+		return err
+	}
+
+	status, _, err := banzaiCli.Client().ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameSyncPut(context.Background(), orgID, options.ClusterGroupID(), releaseName)
+	if err != nil {
+		cli.LogAPIError("sync cluster group deployment", err, releaseName)
+		return errors.WrapIf(utils.ConvertError(err), "failed to synchronize deployment")
+	}
+
+	log.Infof("deployment %q is being synchronized", releaseName)
+	format.ClusterGroupDeploymentTargetsWrite(banzaiCli, []interface{}{status})
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type updateOptions struct {
+	clustergroupcontext.Context
+	requestOptions
+
+	chart       string
+	reuseValues bool
+}
+
+// NewUpdateCommand creates a new cobra.Command for `banzai clustergroup deployment update`.
+func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update [RELEASE_NAME]",
+		Aliases: []string{"u", "upgrade"},
+		Short:   "Update a deployment of a cluster group",
+		Long:    "Update a deployment of a cluster group. The chart, its version and the namespace of the existing deployment are kept unless specified otherwise.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runUpdate(banzaiCli, options, cmd, args)
+		},
+	}
+
+	options.addFlags(cmd)
+	flags := cmd.Flags()
+	flags.StringVar(&options.chart, "chart", "", "Chart to deploy instead of the current one")
+	flags.BoolVar(&options.reuseValues, "reuse-values", false, "Reuse the values of the current release and merge the given ones into them")
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "update")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions, cmd *cobra.Command, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+	clusterGroupID := options.ClusterGroupID()
+
+	var releaseName string
+	if len(args) > 0 {
+		releaseName = args[0]
+	}
+	releaseName, err := getReleaseName(banzaiCli, orgID, clusterGroupID, releaseName)
+	if err != nil {
+		return err
+	}
+
+	request, err := options.buildRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	current, _, err := client.ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNameGet(context.Background(), orgID, clusterGroupID, releaseName)
+	if err != nil {
+		cli.LogAPIError("get cluster group deployment", err, releaseName)
+		return errors.WrapIff(err, "could not get deployment %q", releaseName)
+	}
+
+	request.ReleaseName = releaseName
+	if options.chart != "" {
+		request.Name = options.chart
+	}
+	if request.Name == "" {
+		request.Name = current.Chart
+		if request.Name == "" {
+			request.Name = current.ChartName
+		}
+	}
+	if request.Version == "" && options.chart == "" {
+		request.Version = current.ChartVersion
+	}
+	if request.Namespace == "" {
+		request.Namespace = current.Namespace
+	}
+	if options.reuseValues {
+		request.ReuseValues = true
+	}
+
+	log.Debugf("update cluster group deployment request: %#v", request)
+	response, _, err := client.ClustergroupDeploymentsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdDeploymentsDeploymentNamePut(context.Background(), orgID, clusterGroupID, releaseName, request)
+	if err != nil {
+		cli.LogAPIError("update cluster group deployment", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to update deployment")
+	}
+
+	log.Infof("deployment %q is being updated in cluster group %q", response.ReleaseName, options.ClusterGroupName())
+	format.ClusterGroupDeploymentTargetsWrite(banzaiCli, response.TargetClusters)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feature
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type disableOptions struct {
+	clustergroupcontext.Context
+}
+
+// NewDisableCommand creates a new cobra.Command for `banzai clustergroup feature disable`.
+func NewDisableCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := disableOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "disable [FEATURE]",
+		Aliases: []string{"deactivate", "off", "remove", "rm"},
+		Short:   "Disable a feature of a cluster group",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runDisable(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "disable feature of")
+
+	return cmd
+}
+
+func runDisable(banzaiCli cli.Cli, options disableOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	name, err := getFeatureName(banzaiCli, orgID, options.ClusterGroupID(), name, true)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to DISABLE the feature on the cluster group?"}, &confirmed)
+		if !confirmed {
+			return errors.New("cancelled")
+		}
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNameDelete(context.Background(), orgID, options.ClusterGroupID(), name)
+	if err != nil {
+		cli.LogAPIError("disable cluster group feature", err, name)
+		return errors.WrapIff(utils.ConvertError(err), "failed to disable feature %q", name)
+	}
+
+	log.Infof("feature %q of cluster group %q started to disable", name, options.ClusterGroupName())
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feature
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type enableOptions struct {
+	clustergroupcontext.Context
+	filePath string
+}
+
+// NewEnableCommand creates a new cobra.Command for `banzai clustergroup feature enable`.
+func NewEnableCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := enableOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "enable [FEATURE]",
+		Aliases: []string{"activate", "add", "on"},
+		Short:   "Enable a feature of a cluster group",
+		Long:    "Enable a feature of a cluster group. The properties of the feature are read from the given YAML/JSON file, or from the standard input. In case of interactive mode the properties can be edited in a text editor.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runEnable(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "enable feature of")
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.filePath, "file", "f", "", "Feature properties file")
+
+	return cmd
+}
+
+func runEnable(banzaiCli cli.Cli, options enableOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	name, err := getFeatureName(banzaiCli, orgID, options.ClusterGroupID(), name, false)
+	if err != nil {
+		return err
+	}
+
+	var properties map[string]interface{}
+	if options.filePath == "" && banzaiCli.Interactive() {
+		if properties, err = showPropertiesEditor("Do you want to edit the feature properties in your text editor?", nil); err != nil {
+			return errors.WrapIf(err, "failed during showing editor")
+		}
+	} else {
+		if properties, err = readPropertiesFromFileOrStdin(options.filePath); err != nil {
+			return errors.WrapIff(err, "failed to read properties of feature %q", name)
+		}
+	}
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+
+	_, _, err = banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNamePost(context.Background(), orgID, options.ClusterGroupID(), name, properties)
+	if err != nil {
+		cli.LogAPIError("enable cluster group feature", err, properties)
+		return errors.WrapIff(utils.ConvertError(err), "failed to enable feature %q", name)
+	}
+
+	log.Infof("feature %q of cluster group %q started to enable", name, options.ClusterGroupName())
+
+	return nil
+}
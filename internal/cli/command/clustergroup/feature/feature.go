@@ -0,0 +1,134 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feature
+
+import (
+	"context"
+	"encoding/json"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// getFeatureName returns the given feature name, or asks the user to select one of the features of the cluster group in interactive mode.
+func getFeatureName(banzaiCli cli.Cli, orgID, clusterGroupID int32, name string, enabled bool) (string, error) {
+	if name != "" {
+		return name, nil
+	}
+
+	if !banzaiCli.Interactive() {
+		return "", errors.New("no feature is selected; specify the name of the feature")
+	}
+
+	features, _, err := banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesGet(context.Background(), orgID, clusterGroupID)
+	if err != nil {
+		cli.LogAPIError("list cluster group features", err, clusterGroupID)
+		return "", errors.WrapIf(err, "could not list features")
+	}
+
+	var names []string
+	for _, feature := range features {
+		if feature.Enabled == enabled {
+			names = append(names, feature.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		if enabled {
+			return "", errors.New("there are no enabled features in the cluster group")
+		}
+
+		if err := survey.AskOne(&survey.Input{Message: "Feature:"}, &name, survey.WithValidator(survey.Required)); err != nil {
+			return "", errors.WrapIf(err, "failed to get feature name")
+		}
+
+		return name, nil
+	}
+
+	if err := survey.AskOne(&survey.Select{Message: "Feature:", Options: names}, &name, survey.WithValidator(survey.Required)); err != nil {
+		return "", errors.WrapIf(err, "failed to select a feature")
+	}
+
+	return name, nil
+}
+
+func readPropertiesFromFileOrStdin(filePath string) (map[string]interface{}, error) {
+	filename, raw, err := utils.ReadFileOrStdin(filePath)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+	}
+
+	var properties map[string]interface{}
+	if err := utils.Unmarshal(raw, &properties); err != nil {
+		return nil, errors.WrapIf(err, "failed to unmarshal input")
+	}
+
+	return properties, nil
+}
+
+func showPropertiesEditor(message string, properties map[string]interface{}) (map[string]interface{}, error) {
+	var edit bool
+	if err := survey.AskOne(
+		&survey.Confirm{
+			Message: message,
+		},
+		&edit,
+	); err != nil {
+		return nil, errors.WrapIf(err, "failure during survey")
+	}
+	if !edit {
+		return properties, nil
+	}
+
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+
+	content, err := json.MarshalIndent(properties, "", "  ")
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to marshal properties to JSON")
+	}
+	var result string
+	if err := survey.AskOne(
+		&survey.Editor{
+			Default:       string(content),
+			HideDefault:   true,
+			AppendDefault: true,
+		},
+		&result,
+		survey.WithValidator(validateProperties),
+	); err != nil {
+		return nil, errors.WrapIf(err, "failure during survey")
+	}
+
+	var edited map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &edited); err != nil {
+		return nil, errors.WrapIf(err, "failed to unmarshal JSON as properties")
+	}
+
+	return edited, nil
+}
+
+func validateProperties(v interface{}) error {
+	var properties map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &properties); err != nil {
+		return errors.WrapIf(err, "properties are not a valid JSON object")
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feature
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type getOptions struct {
+	clustergroupcontext.Context
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai clustergroup feature get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get [FEATURE]",
+		Aliases: []string{"details", "show", "query"},
+		Short:   "Get details of a feature of a cluster group",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runGet(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "get feature details of")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(); err != nil {
+		return err
+	}
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	name, err := getFeatureName(banzaiCli, orgID, options.ClusterGroupID(), name, true)
+	if err != nil {
+		return err
+	}
+
+	feature, _, err := banzaiCli.Client().ClustergroupFeaturesApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdFeaturesFeatureNameGet(context.Background(), orgID, options.ClusterGroupID(), name)
+	if err != nil {
+		cli.LogAPIError("get cluster group feature", err, name)
+		return errors.WrapIff(err, "could not get feature %q", name)
+	}
+
+	format.ClusterGroupFeatureWrite(banzaiCli, feature)
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		return nil
+	}
+
+	for _, section := range []struct {
+		title  string
+		values map[string]interface{}
+	}{
+		{title: "Properties", values: feature.Properties},
+		{title: "Status", values: feature.Status},
+	} {
+		if len(section.values) == 0 {
+			continue
+		}
+
+		content, err := yaml.Marshal(section.values)
+		if err != nil {
+			return errors.WrapIff(err, "failed to marshal feature %s", section.title)
+		}
+
+		fmt.Fprintf(banzaiCli.Out(), "\n%s\n----\n%s", section.title, content)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type getOptions struct {
+	clustergroupcontext.Context
+}
+
+// NewGetCommand creates a new cobra.Command for `banzai clustergroup get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := getOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "get [NAME]",
+		Aliases: []string{"g", "show"},
+		Short:   "Get details of a cluster group",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runGet(banzaiCli, options, args)
+		},
+	}
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "get")
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, options getOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(args...); err != nil {
+		return err
+	}
+
+	clusterGroup, _, err := banzaiCli.Client().ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdGet(context.Background(), orgID, options.ClusterGroupID())
+	if err != nil {
+		cli.LogAPIError("get cluster group", err, options.ClusterGroupID())
+		return errors.WrapIf(err, "could not get cluster group")
+	}
+
+	format.ClusterGroupWrite(banzaiCli, clusterGroup)
+
+	if banzaiCli.OutputFormat() == output.OutputFormatDefault && len(clusterGroup.Members) > 0 {
+		fmt.Fprintln(banzaiCli.Out())
+		format.ClusterGroupMembersWrite(banzaiCli, clusterGroup.Members)
+	}
+
+	return nil
+}
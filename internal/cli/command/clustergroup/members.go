@@ -0,0 +1,84 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+func listClusters(banzaiCli cli.Cli, orgID int32) ([]pipeline.GetClusterStatusResponse, error) {
+	clusters, _, err := banzaiCli.Client().ClustersApi.ListClusters(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list clusters", err, orgID)
+		return nil, errors.WrapIf(err, "could not list clusters")
+	}
+
+	return clusters, nil
+}
+
+// resolveMembers converts a list of cluster names or IDs to cluster IDs.
+func resolveMembers(clusters []pipeline.GetClusterStatusResponse, refs []string) ([]int32, error) {
+	members := make([]int32, 0, len(refs))
+
+	for _, ref := range refs {
+		found := false
+		for _, cluster := range clusters {
+			if cluster.Name == ref || strconv.Itoa(int(cluster.Id)) == ref {
+				members = append(members, cluster.Id)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, errors.Errorf("could not find cluster %q", ref)
+		}
+	}
+
+	return members, nil
+}
+
+// askMembers asks the user to select the member clusters of a cluster group.
+func askMembers(clusters []pipeline.GetClusterStatusResponse, current []int32) ([]int32, error) {
+	if len(clusters) == 0 {
+		return nil, errors.New("there are no clusters in the organization")
+	}
+
+	options := make([]string, len(clusters))
+	var defaults []string
+	for i, cluster := range clusters {
+		options[i] = cluster.Name
+		for _, id := range current {
+			if id == cluster.Id {
+				defaults = append(defaults, cluster.Name)
+			}
+		}
+	}
+
+	var selected []string
+	err := survey.AskOne(&survey.MultiSelect{Message: "Member clusters:", Options: options, Default: defaults}, &selected, survey.WithValidator(survey.Required))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to select member clusters")
+	}
+
+	return resolveMembers(clusters, selected)
+}
@@ -0,0 +1,170 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroup
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	clustergroupcontext "github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup/context"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type updateOptions struct {
+	clustergroupcontext.Context
+
+	name          string
+	members       []string
+	addMembers    []string
+	removeMembers []string
+}
+
+// NewUpdateCommand creates a new cobra.Command for `banzai clustergroup update`.
+func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update [NAME]",
+		Aliases: []string{"u"},
+		Short:   "Update a cluster group",
+		Long: "Rename a cluster group or change its member clusters. " +
+			"The members can be replaced with --member, or changed with --add-member and --remove-member. " +
+			"In case of interactive mode banzai CLI will prompt for the members if none of these flags are given.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runUpdate(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.name, "name", "", "New name of the cluster group")
+	flags.StringSliceVarP(&options.members, "member", "m", nil, "Name or ID of a member cluster, replacing the current members (can be repeated)")
+	flags.StringSliceVar(&options.addMembers, "add-member", nil, "Name or ID of a cluster to add to the group (can be repeated)")
+	flags.StringSliceVar(&options.removeMembers, "remove-member", nil, "Name or ID of a cluster to remove from the group (can be repeated)")
+
+	options.Context = clustergroupcontext.NewClusterGroupContext(cmd, banzaiCli, "update")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if err := options.Init(args...); err != nil {
+		return err
+	}
+	clusterGroupID := options.ClusterGroupID()
+
+	if len(options.members) > 0 && (len(options.addMembers) > 0 || len(options.removeMembers) > 0) {
+		return errors.New("--member can't be used together with --add-member or --remove-member")
+	}
+
+	clusterGroup, _, err := client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdGet(context.Background(), orgID, clusterGroupID)
+	if err != nil {
+		cli.LogAPIError("get cluster group", err, clusterGroupID)
+		return errors.WrapIf(err, "could not get cluster group")
+	}
+
+	request := pipeline.ApiUpdateRequest{
+		Name: clusterGroup.Name,
+	}
+	if options.name != "" {
+		request.Name = options.name
+	}
+
+	current := make([]int32, len(clusterGroup.Members))
+	for i, member := range clusterGroup.Members {
+		current[i] = member.Id
+	}
+
+	clusters, err := listClusters(banzaiCli, orgID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(options.members) > 0:
+		request.Members, err = resolveMembers(clusters, options.members)
+
+	case len(options.addMembers) > 0 || len(options.removeMembers) > 0:
+		request.Members, err = changeMembers(clusters, current, options.addMembers, options.removeMembers)
+
+	case banzaiCli.Interactive() && options.name == "":
+		request.Members, err = askMembers(clusters, current)
+
+	default:
+		request.Members = current
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(request.Members) == 0 {
+		return errors.New("a cluster group must have at least one member")
+	}
+
+	log.Debugf("update cluster group request: %#v", request)
+	_, _, err = client.ClustergroupsApi.ApiV1OrgsOrgidClustergroupsClusterGroupIdPut(context.Background(), orgID, clusterGroupID, request)
+	if err != nil {
+		cli.LogAPIError("update cluster group", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to update cluster group")
+	}
+
+	log.Infof("cluster group %q updated", request.Name)
+	return nil
+}
+
+func changeMembers(clusters []pipeline.GetClusterStatusResponse, current []int32, add, remove []string) ([]int32, error) {
+	added, err := resolveMembers(clusters, add)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := resolveMembers(clusters, remove)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]int32, 0, len(current)+len(added))
+	seen := make(map[int32]bool)
+	for _, id := range append(current, added...) {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		keep := true
+		for _, r := range removed {
+			if r == id {
+				keep = false
+				break
+			}
+		}
+
+		if keep {
+			members = append(members, id)
+		}
+	}
+
+	return members, nil
+}
@@ -18,13 +18,21 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/azure"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/backup"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/bucket"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/cluster"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/clustergroup"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/controlplane"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/form"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/helm"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/login"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/network"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/organization"
 	"github.com/banzaicloud/banzai-cli/internal/cli/command/secret"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/spotguide"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/token"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/user"
 )
 
 // AddCommands adds all the commands from cli/command to the root command
@@ -32,11 +40,20 @@ func AddCommands(cmd *cobra.Command, banzaiCli cli.Cli) {
 	cmd.AddCommand(
 		login.NewLoginCommand(banzaiCli),
 
+		azure.NewAzureCommand(banzaiCli),
 		cluster.NewClusterCommand(banzaiCli),
+		clustergroup.NewClusterGroupCommand(banzaiCli),
 		form.NewFormCommand(banzaiCli),
+		helm.NewHelmCommand(banzaiCli),
+		network.NewNetworkCommand(banzaiCli),
 		organization.NewOrganizationCommand(banzaiCli),
 		secret.NewSecretCommand(banzaiCli),
+		spotguide.NewSpotguideCommand(banzaiCli),
+		token.NewTokenCommand(banzaiCli),
+		user.NewUserCommand(banzaiCli),
+		user.NewWhoAmICommand(banzaiCli),
 		controlplane.NewControlPlaneCommand(banzaiCli),
 		bucket.NewBucketCommand(banzaiCli),
+		backup.NewBackupCommand(banzaiCli),
 	)
 }
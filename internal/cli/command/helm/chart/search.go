@@ -0,0 +1,131 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type searchOptions struct {
+	repo    string
+	version string
+}
+
+type chartListItem struct {
+	Repo        string
+	Name        string
+	Version     string
+	AppVersion  string
+	Description string
+}
+
+// NewSearchCommand creates a new cobra.Command for `banzai helm chart search`.
+func NewSearchCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := searchOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "search [KEYWORD]",
+		Aliases: []string{"s", "list", "ls"},
+		Short:   "Search Helm charts",
+		Long:    "Search Helm charts in the repositories of the organization. The latest version of each matching chart is listed.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runSearch(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.repo, "repo", "r", "", "Search only in the given repository")
+	flags.StringVar(&options.version, "version", "", "Search for the given chart version")
+
+	return cmd
+}
+
+func runSearch(banzaiCli cli.Cli, options searchOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	opts := &pipeline.HelmChartListOpts{}
+	if len(args) > 0 {
+		opts.Name = optional.NewString(args[0])
+	}
+	if options.repo != "" {
+		opts.Repo = optional.NewString(options.repo)
+	}
+	if options.version != "" {
+		opts.Version = optional.NewString(options.version)
+	}
+
+	charts, _, err := banzaiCli.Client().HelmApi.HelmChartList(context.Background(), orgID, opts)
+	if err != nil {
+		cli.LogAPIError("list Helm charts", err, opts)
+		return errors.WrapIf(err, "could not list Helm charts")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.HelmChartsWrite(banzaiCli, charts)
+		return nil
+	}
+
+	format.HelmChartsWrite(banzaiCli, flattenCharts(charts))
+
+	return nil
+}
+
+// flattenCharts converts the per-repository chart version lists returned by Pipeline to one item per chart, with its first (latest) version.
+func flattenCharts(repos []map[string]interface{}) []chartListItem {
+	var items []chartListItem
+	for _, repo := range repos {
+		repoName := fmt.Sprint(repo["name"])
+
+		charts, _ := repo["charts"].([]interface{})
+		for _, chart := range charts {
+			versions, _ := chart.([]interface{})
+			if len(versions) == 0 {
+				continue
+			}
+
+			latest, _ := versions[0].(map[string]interface{})
+			items = append(items, chartListItem{
+				Repo:        repoName,
+				Name:        stringValue(latest, "name"),
+				Version:     stringValue(latest, "version"),
+				AppVersion:  stringValue(latest, "appVersion"),
+				Description: stringValue(latest, "description"),
+			})
+		}
+	}
+
+	return items
+}
+
+func stringValue(m map[string]interface{}, key string) string {
+	if value, ok := m[key].(string); ok {
+		return value
+	}
+
+	return ""
+}
@@ -0,0 +1,53 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCharts(t *testing.T) {
+	raw := `[
+		{
+			"name": "stable",
+			"charts": [
+				[
+					{"name": "nginx-ingress", "version": "1.24.4", "appVersion": "0.26.1", "description": "An nginx Ingress controller"},
+					{"name": "nginx-ingress", "version": "1.24.3", "appVersion": "0.26.1", "description": "An nginx Ingress controller"}
+				],
+				[]
+			]
+		},
+		{
+			"name": "banzaicloud-stable",
+			"charts": [
+				[
+					{"name": "vault-operator", "version": "1.3.0"}
+				]
+			]
+		}
+	]`
+
+	var repos []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(raw), &repos))
+
+	require.Equal(t, []chartListItem{
+		{Repo: "stable", Name: "nginx-ingress", Version: "1.24.4", AppVersion: "0.26.1", Description: "An nginx Ingress controller"},
+		{Repo: "banzaicloud-stable", Name: "vault-operator", Version: "1.3.0"},
+	}, flattenCharts(repos))
+}
@@ -0,0 +1,117 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"emperror.dev/errors"
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type showOptions struct {
+	version string
+	readme  bool
+	values  bool
+}
+
+// NewShowCommand creates a new cobra.Command for `banzai helm chart show`.
+func NewShowCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := showOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "show REPO/CHART",
+		Aliases: []string{"get", "inspect"},
+		Short:   "Show details of a Helm chart",
+		Long:    "Show details of a Helm chart, and optionally its readme and default values.",
+		Example: `
+		$ banzai helm chart show stable/nginx-ingress --values`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runShow(banzaiCli, options, args[0])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.version, "version", "", "Version of the chart (the latest one if not given)")
+	flags.BoolVar(&options.readme, "readme", false, "Show the readme of the chart")
+	flags.BoolVar(&options.values, "values", false, "Show the default values of the chart")
+
+	return cmd
+}
+
+func runShow(banzaiCli cli.Cli, options showOptions, ref string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return errors.Errorf("invalid chart reference %q, expected REPO/CHART format", ref)
+	}
+
+	opts := &pipeline.HelmChartDetailsOpts{}
+	if options.version != "" {
+		opts.Version = optional.NewString(options.version)
+	}
+
+	details, _, err := banzaiCli.Client().HelmApi.HelmChartDetails(context.Background(), orgID, parts[0], parts[1], opts)
+	if err != nil {
+		cli.LogAPIError("get Helm chart details", err, ref)
+		return errors.WrapIff(err, "could not get details of chart %q", ref)
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.HelmChartWrite(banzaiCli, details)
+		return nil
+	}
+
+	if len(details.Versions) == 0 {
+		return errors.Errorf("chart %q has no versions", ref)
+	}
+	version := details.Versions[0]
+
+	format.HelmChartWrite(banzaiCli, version.Chart)
+
+	if options.readme && version.Readme != "" {
+		fmt.Fprintf(banzaiCli.Out(), "\nReadme\n----\n%s\n", decodeContent(version.Readme))
+	}
+
+	if options.values && version.Values != "" {
+		fmt.Fprintf(banzaiCli.Out(), "\nValues\n----\n%s\n", decodeContent(version.Values))
+	}
+
+	return nil
+}
+
+// decodeContent returns the decoded content of a file of a chart, which is sent base64 encoded by Pipeline.
+func decodeContent(content string) string {
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil || !utf8.Valid(decoded) {
+		return content
+	}
+
+	return string(decoded)
+}
@@ -0,0 +1,38 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helm
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/helm/chart"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/helm/repo"
+)
+
+// NewHelmCommand returns a cobra command for `helm` subcommands.
+func NewHelmCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "helm",
+		Short: "Manage Helm repositories and charts of the organization",
+	}
+
+	cmd.AddCommand(
+		chart.NewChartCommand(banzaiCli),
+		repo.NewRepoCommand(banzaiCli),
+	)
+
+	return cmd
+}
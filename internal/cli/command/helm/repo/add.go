@@ -0,0 +1,84 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type addOptions struct {
+	certFile string
+	keyFile  string
+	caFile   string
+}
+
+// NewAddCommand creates a new cobra.Command for `banzai helm repo add`.
+func NewAddCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := addOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add NAME URL",
+		Aliases: []string{"a", "create"},
+		Short:   "Add a Helm repository to the organization",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runAdd(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.certFile, "cert-file", "", "Client certificate file for the repository")
+	flags.StringVar(&options.keyFile, "key-file", "", "Client key file for the repository")
+	flags.StringVar(&options.caFile, "ca-file", "", "CA bundle file for verifying the certificate of the repository")
+
+	return cmd
+}
+
+func runAdd(banzaiCli cli.Cli, options addOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	request := pipeline.HelmReposAddRequest{
+		Name:     args[0],
+		Url:      args[1],
+		CertFile: options.certFile,
+		KeyFile:  options.keyFile,
+		CaFile:   options.caFile,
+	}
+
+	log.Debugf("add Helm repository request: %#v", request)
+	repo, _, err := banzaiCli.Client().HelmApi.HelmReposAdd(context.Background(), orgID, request)
+	if err != nil {
+		cli.LogAPIError("add Helm repository", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to add Helm repository")
+	}
+
+	if err := invalidateCache(banzaiCli, orgID); err != nil {
+		return err
+	}
+
+	log.Infof("Helm repository %q added", repo.Name)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// HelmHome returns the path of the local Helm home of an organization, which is synchronized with the repositories of Pipeline.
+func HelmHome(banzaiCli cli.Cli, orgID int32) string {
+	return filepath.Join(banzaiCli.Home(), fmt.Sprintf("helm/org-%d", orgID))
+}
+
+// RepositoriesDir returns the path of the repository configuration and cache directory in the local Helm home of an organization.
+func RepositoriesDir(banzaiCli cli.Cli, orgID int32) string {
+	return filepath.Join(HelmHome(banzaiCli, orgID), "repository")
+}
+
+// invalidateCache removes the repository configuration and cache of the local Helm home of an organization,
+// so that it is recreated from the current list of Pipeline repositories the next time it is used.
+func invalidateCache(banzaiCli cli.Cli, orgID int32) error {
+	dir := RepositoriesDir(banzaiCli, orgID)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	log.Debugf("removing Helm repository cache %q", dir)
+	return errors.WrapIff(os.RemoveAll(dir), "failed to remove Helm repository cache %q", dir)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+)
+
+// NewListCommand creates a new cobra.Command for `banzai helm repo list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List Helm repositories of the organization",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runList(banzaiCli)
+		},
+	}
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	repos, _, err := banzaiCli.Client().HelmApi.HelmListRepos(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list Helm repositories", err, orgID)
+		return errors.WrapIf(err, "could not list Helm repositories")
+	}
+
+	format.HelmReposWrite(banzaiCli, repos)
+
+	return nil
+}
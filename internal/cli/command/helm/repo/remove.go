@@ -0,0 +1,70 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewRemoveCommand creates a new cobra.Command for `banzai helm repo remove`.
+func NewRemoveCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove NAME",
+		Aliases: []string{"rm", "delete", "d"},
+		Short:   "Remove a Helm repository from the organization",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runRemove(banzaiCli, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runRemove(banzaiCli cli.Cli, name string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if banzaiCli.Interactive() {
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to REMOVE the Helm repository?"}, &confirmed)
+		if !confirmed {
+			return errors.New("removal cancelled")
+		}
+	}
+
+	_, _, err := banzaiCli.Client().HelmApi.HelmReposDelete(context.Background(), orgID, name)
+	if err != nil {
+		cli.LogAPIError("remove Helm repository", err, name)
+		return errors.WrapIf(utils.ConvertError(err), "failed to remove Helm repository")
+	}
+
+	if err := invalidateCache(banzaiCli, orgID); err != nil {
+		return err
+	}
+
+	log.Infof("Helm repository %q removed", name)
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type updateOptions struct {
+	url      string
+	certFile string
+	keyFile  string
+	caFile   string
+}
+
+// NewUpdateCommand creates a new cobra.Command for `banzai helm repo update`.
+func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update [NAME...]",
+		Aliases: []string{"u", "up", "modify"},
+		Short:   "Update Helm repositories of the organization",
+		Long:    "Refresh the index of the given Helm repositories, or all of them if none is given. If --url or any of the certificate options is specified, the settings of the given repository are changed as well.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runUpdate(banzaiCli, options, cmd, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.url, "url", "", "New URL of the repository")
+	flags.StringVar(&options.certFile, "cert-file", "", "Client certificate file for the repository")
+	flags.StringVar(&options.keyFile, "key-file", "", "Client key file for the repository")
+	flags.StringVar(&options.caFile, "ca-file", "", "CA bundle file for verifying the certificate of the repository")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions, cmd *cobra.Command, args []string) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	flags := cmd.Flags()
+	modify := flags.Changed("url") || flags.Changed("cert-file") || flags.Changed("key-file") || flags.Changed("ca-file")
+
+	if modify {
+		if len(args) != 1 {
+			return errors.New("exactly one repository must be specified to change its settings")
+		}
+
+		request := pipeline.HelmReposModifyRequest{
+			Name:     args[0],
+			Url:      options.url,
+			CertFile: options.certFile,
+			KeyFile:  options.keyFile,
+			CaFile:   options.caFile,
+		}
+
+		log.Debugf("modify Helm repository request: %#v", request)
+		_, _, err := client.HelmApi.HelmReposModify(context.Background(), orgID, args[0], request)
+		if err != nil {
+			cli.LogAPIError("modify Helm repository", err, request)
+			return errors.WrapIf(utils.ConvertError(err), "failed to modify Helm repository")
+		}
+
+		log.Infof("Helm repository %q modified", args[0])
+
+		return invalidateCache(banzaiCli, orgID)
+	}
+
+	names := args
+	if len(names) == 0 {
+		repos, _, err := client.HelmApi.HelmListRepos(context.Background(), orgID)
+		if err != nil {
+			cli.LogAPIError("list Helm repositories", err, orgID)
+			return errors.WrapIf(err, "could not list Helm repositories")
+		}
+
+		for _, repo := range repos {
+			names = append(names, repo.Name)
+		}
+	}
+
+	var errs []error
+	for _, name := range names {
+		if _, _, err := client.HelmApi.HelmReposUpdate(context.Background(), orgID, name); err != nil {
+			cli.LogAPIError("update Helm repository", err, name)
+			errs = append(errs, errors.WrapIff(utils.ConvertError(err), "failed to update Helm repository %q", name))
+			continue
+		}
+
+		log.Infof("Helm repository %q updated", name)
+	}
+
+	if err := invalidateCache(banzaiCli, orgID); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Combine(errs...)
+}
@@ -0,0 +1,128 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type locationOptions struct {
+	secretID      string
+	region        string
+	resourceGroup string
+}
+
+func (o *locationOptions) addFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVar(&o.secretID, "secret-id", "", "ID of the secret to access the cloud provider with")
+	flags.StringVar(&o.region, "region", "", "Region of the networks (required except for Azure)")
+	flags.StringVar(&o.resourceGroup, "resource-group", "", "Resource group of the networks (required for Azure)")
+}
+
+// location completes the options from the secret and, in interactive mode, from the user.
+func (o locationOptions) location(banzaiCli cli.Cli, orgID int32) (input.NetworkLocation, error) {
+	location := input.NetworkLocation{
+		SecretID:      o.secretID,
+		Region:        o.region,
+		ResourceGroup: o.resourceGroup,
+	}
+
+	if location.SecretID == "" {
+		if !banzaiCli.Interactive() {
+			return location, errors.New("no secret is selected; use the --secret-id option")
+		}
+
+		cloud, err := input.AskCloud()
+		if err != nil {
+			return location, err
+		}
+
+		if location.SecretID, err = input.AskSecret(banzaiCli, orgID, cloud); err != nil {
+			return location, err
+		}
+	}
+
+	secret, _, err := banzaiCli.Client().SecretsApi.GetSecret(context.Background(), orgID, location.SecretID)
+	if err != nil {
+		cli.LogAPIError("get secret", err, location.SecretID)
+		return location, errors.WrapIf(utils.ConvertError(err), "could not get secret")
+	}
+	location.Cloud = secret.Type
+
+	if err := input.IsCloudProviderSupported(location.Cloud); err != nil {
+		return location, errors.WrapIff(err, "secret %q can't be used to list networks", secret.Name)
+	}
+
+	if location.Cloud == input.CloudProviderAzure {
+		if location.ResourceGroup == "" {
+			if !banzaiCli.Interactive() {
+				return location, errors.New("--resource-group must be specified for Azure")
+			}
+
+			if location.ResourceGroup, err = input.AskResourceGroup(banzaiCli, orgID, location.SecretID, ""); err != nil {
+				return location, err
+			}
+		}
+	} else if location.Region == "" {
+		if !banzaiCli.Interactive() {
+			return location, errors.New("--region must be specified")
+		}
+
+		if location.Region, err = input.AskLocation(banzaiCli, location.Cloud); err != nil {
+			return location, err
+		}
+	}
+
+	return location, nil
+}
+
+// vpcOptions are the options of commands listing resources of a VPC network.
+type vpcOptions struct {
+	locationOptions
+	vpcID string
+}
+
+func (o *vpcOptions) addFlags(cmd *cobra.Command) {
+	o.locationOptions.addFlags(cmd)
+	cmd.Flags().StringVar(&o.vpcID, "vpc-id", "", "ID of the VPC network")
+}
+
+// location completes the options, and asks for the VPC network in interactive mode if it is not given.
+func (o vpcOptions) location(banzaiCli cli.Cli, orgID int32) (input.NetworkLocation, string, error) {
+	location, err := o.locationOptions.location(banzaiCli, orgID)
+	if err != nil {
+		return location, "", err
+	}
+
+	vpcID := o.vpcID
+	if vpcID == "" {
+		if !banzaiCli.Interactive() {
+			return location, "", errors.New("no VPC network is selected; use the --vpc-id option")
+		}
+
+		if vpcID, err = input.AskVPCNetwork(banzaiCli, orgID, location); err != nil {
+			return location, "", err
+		}
+	}
+
+	return location, vpcID, nil
+}
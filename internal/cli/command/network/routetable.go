@@ -0,0 +1,78 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+)
+
+// NewRouteTableCommand returns a cobra command for `routetable` subcommands.
+func NewRouteTableCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "routetable",
+		Aliases: []string{"routetables", "rt"},
+		Short:   "Discover route tables of VPC networks",
+	}
+
+	cmd.AddCommand(
+		newRouteTableListCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newRouteTableListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := vpcOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List route tables of a VPC network",
+		Example: `
+		$ banzai network routetable list --secret-id 0123456789abcdef --region us-east-2 --vpc-id vpc-0deb755857f5ef79d`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runRouteTableList(banzaiCli, options)
+		},
+	}
+
+	options.addFlags(cmd)
+
+	return cmd
+}
+
+func runRouteTableList(banzaiCli cli.Cli, options vpcOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	location, vpcID, err := options.location(banzaiCli, orgID)
+	if err != nil {
+		return err
+	}
+
+	routeTables, err := input.ListRouteTables(banzaiCli, orgID, location, vpcID)
+	if err != nil {
+		return err
+	}
+
+	format.RouteTablesWrite(banzaiCli, routeTables)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type subnetRow struct {
+	Id       string
+	Name     string
+	Cidrs    string
+	Location string
+}
+
+// NewSubnetCommand returns a cobra command for `subnet` subcommands.
+func NewSubnetCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "subnet",
+		Aliases: []string{"subnets"},
+		Short:   "Discover subnets of VPC networks",
+	}
+
+	cmd.AddCommand(
+		newSubnetListCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newSubnetListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := vpcOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List subnets of a VPC network",
+		Example: `
+		$ banzai network subnet list --secret-id 0123456789abcdef --region us-east-2 --vpc-id vpc-0deb755857f5ef79d`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runSubnetList(banzaiCli, options)
+		},
+	}
+
+	options.addFlags(cmd)
+
+	return cmd
+}
+
+func runSubnetList(banzaiCli cli.Cli, options vpcOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	location, vpcID, err := options.location(banzaiCli, orgID)
+	if err != nil {
+		return err
+	}
+
+	subnets, err := input.ListVPCSubnets(banzaiCli, orgID, location, vpcID)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.SubnetsWrite(banzaiCli, subnets)
+		return nil
+	}
+
+	rows := make([]subnetRow, len(subnets))
+	for i, subnet := range subnets {
+		rows[i] = subnetRow{
+			Id:       subnet.Id,
+			Name:     subnet.Name,
+			Cidrs:    strings.Join(subnet.Cidrs, ", "),
+			Location: subnet.Location,
+		}
+	}
+
+	format.SubnetsWrite(banzaiCli, rows)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type vpcRow struct {
+	Id    string
+	Name  string
+	Cidrs string
+}
+
+// NewVPCCommand returns a cobra command for `vpc` subcommands.
+func NewVPCCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vpc",
+		Aliases: []string{"vpcs"},
+		Short:   "Discover VPC networks",
+	}
+
+	cmd.AddCommand(
+		newVPCListCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newVPCListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := locationOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List VPC networks",
+		Example: `
+		$ banzai network vpc list --secret-id 0123456789abcdef --region us-east-2`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runVPCList(banzaiCli, options)
+		},
+	}
+
+	options.addFlags(cmd)
+
+	return cmd
+}
+
+func runVPCList(banzaiCli cli.Cli, options locationOptions) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	location, err := options.location(banzaiCli, orgID)
+	if err != nil {
+		return err
+	}
+
+	networks, err := input.ListVPCNetworks(banzaiCli, orgID, location)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.VPCNetworksWrite(banzaiCli, networks)
+		return nil
+	}
+
+	rows := make([]vpcRow, len(networks))
+	for i, network := range networks {
+		rows[i] = vpcRow{
+			Id:    network.Id,
+			Name:  network.Name,
+			Cidrs: strings.Join(network.Cidrs, ", "),
+		}
+	}
+
+	format.VPCNetworksWrite(banzaiCli, rows)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/command/user"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewMembersCommand creates a new cobra.Command for `banzai organization members`.
+func NewMembersCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "members",
+		Aliases: []string{"member", "users"},
+		Short:   "List members of the current organization",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runMembers(banzaiCli)
+		},
+	}
+
+	return cmd
+}
+
+func runMembers(banzaiCli cli.Cli) error {
+	client := banzaiCli.Client()
+	orgID := banzaiCli.Context().OrganizationID()
+
+	users, _, err := client.UsersApi.ListUsers(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list users", err, orgID)
+		return errors.WrapIf(utils.ConvertError(err), "failed to list members of the organization")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.UsersWrite(banzaiCli, users)
+		return nil
+	}
+
+	org, _, err := client.OrganizationsApi.GetOrg(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("get organization", err, orgID)
+		return errors.WrapIf(utils.ConvertError(err), "failed to get organization")
+	}
+
+	format.UsersWrite(banzaiCli, user.NewRows(users, org.Name))
+
+	return nil
+}
@@ -33,6 +33,9 @@ func NewSecretCommand(banzaiCli cli.Cli) *cobra.Command {
 		NewDeleteCommand(banzaiCli),
 		NewCreateCommand(banzaiCli),
 		NewInstallCommand(banzaiCli),
+		NewTagCommand(banzaiCli),
+		NewUpdateCommand(banzaiCli),
+		NewValidateCommand(banzaiCli),
 	)
 
 	return cmd
@@ -16,11 +16,9 @@ package secret
 
 import (
 	"context"
-	"fmt"
 
 	"emperror.dev/errors"
 
-	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/banzaicloud/banzai-cli/internal/cli/format"
 	"github.com/banzaicloud/banzai-cli/internal/cli/input"
@@ -62,25 +60,10 @@ func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
 }
 
 func runGet(banzaiCli cli.Cli, options getOptions) error {
-	if options.id == "" && options.name == "" {
-		return errors.New("specify either the name or the ID of the secret")
-	}
 	orgID := input.GetOrganization(banzaiCli)
-	id := options.id
-	if id == "" {
-		secrets, _, err := banzaiCli.Client().SecretsApi.GetSecrets(context.Background(), orgID, &pipeline.GetSecretsOpts{})
-		if err != nil {
-			return errors.WrapIf(err, "could not list secrets")
-		}
-		for _, secret := range secrets {
-			if secret.Name == options.name {
-				id = secret.Id
-				break
-			}
-		}
-		if id == "" {
-			return fmt.Errorf("can't find secret named %q", options.name)
-		}
+	id, err := getSecretID(banzaiCli, orgID, options.name, options.id)
+	if err != nil {
+		return err
 	}
 
 	secret, _, err := banzaiCli.Client().SecretsApi.GetSecret(context.Background(), orgID, id)
@@ -0,0 +1,49 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// getSecretID returns the given secret ID, or looks up the ID of the secret with the given name.
+func getSecretID(banzaiCli cli.Cli, orgID int32, name, id string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+
+	if name == "" {
+		return "", errors.New("specify either the name or the ID of the secret")
+	}
+
+	secrets, _, err := banzaiCli.Client().SecretsApi.GetSecrets(context.Background(), orgID, &pipeline.GetSecretsOpts{})
+	if err != nil {
+		return "", errors.WrapIf(utils.ConvertError(err), "could not list secrets")
+	}
+
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return secret.Id, nil
+		}
+	}
+
+	return "", errors.Errorf("can't find secret named %q", name)
+}
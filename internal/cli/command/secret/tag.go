@@ -0,0 +1,218 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type tagOptions struct {
+	format string
+	id     string
+}
+
+type tagRow struct {
+	Tag string
+}
+
+// NewTagCommand returns a cobra command for `secret tag` subcommands.
+func NewTagCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tag",
+		Aliases: []string{"tags", "t"},
+		Short:   "Manage secret tags",
+	}
+
+	cmd.AddCommand(
+		newTagAddCommand(banzaiCli),
+		newTagListCommand(banzaiCli),
+		newTagRemoveCommand(banzaiCli),
+	)
+
+	return cmd
+}
+
+func newTagAddCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := tagOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "add (NAME | --id=ID) TAG...",
+		Aliases: []string{"a"},
+		Short:   "Add tags to a secret",
+		Example: `
+		$ banzai secret tag add mysecret cli my-application
+		$ banzai secret tag add --id 0123456789abcdef cli`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			options.format, _ = cmd.Flags().GetString("output")
+
+			return runTagAdd(banzaiCli, options, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.id, "id", "i", "", "ID of the secret")
+
+	return cmd
+}
+
+func newTagRemoveCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := tagOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "remove (NAME | --id=ID) TAG...",
+		Aliases: []string{"rm", "delete", "del"},
+		Short:   "Remove tags from a secret",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			options.format, _ = cmd.Flags().GetString("output")
+
+			return runTagRemove(banzaiCli, options, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.id, "id", "i", "", "ID of the secret")
+
+	return cmd
+}
+
+func newTagListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := tagOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list (NAME | --id=ID)",
+		Aliases: []string{"l", "ls"},
+		Short:   "List tags of a secret",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			options.format, _ = cmd.Flags().GetString("output")
+
+			return runTagList(banzaiCli, options, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.id, "id", "i", "", "ID of the secret")
+
+	return cmd
+}
+
+// parseTagArgs splits the arguments to the secret ID and the tags.
+func parseTagArgs(banzaiCli cli.Cli, orgID int32, options tagOptions, args []string) (string, []string, error) {
+	var name string
+	if options.id == "" && len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	id, err := getSecretID(banzaiCli, orgID, name, options.id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return id, args, nil
+}
+
+func runTagAdd(banzaiCli cli.Cli, options tagOptions, args []string) error {
+	orgID := input.GetOrganization(banzaiCli)
+
+	id, tags, err := parseTagArgs(banzaiCli, orgID, options, args)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return errors.New("specify at least one tag to add")
+	}
+
+	var current []string
+	for _, tag := range tags {
+		if current, _, err = banzaiCli.Client().SecretsApi.AddSecretTag(context.Background(), orgID, id, tag); err != nil {
+			cli.LogAPIError("add secret tag", err, tag)
+			return errors.WrapIff(utils.ConvertError(err), "could not add tag %q", tag)
+		}
+	}
+
+	writeTags(banzaiCli, options, current)
+
+	return nil
+}
+
+func runTagRemove(banzaiCli cli.Cli, options tagOptions, args []string) error {
+	orgID := input.GetOrganization(banzaiCli)
+
+	id, tags, err := parseTagArgs(banzaiCli, orgID, options, args)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return errors.New("specify at least one tag to remove")
+	}
+
+	for _, tag := range tags {
+		if _, err := banzaiCli.Client().SecretsApi.DeleteSecretTag(context.Background(), orgID, id, tag); err != nil {
+			cli.LogAPIError("delete secret tag", err, tag)
+			return errors.WrapIff(utils.ConvertError(err), "could not remove tag %q", tag)
+		}
+
+		log.Infof("tag %q removed", tag)
+	}
+
+	return nil
+}
+
+func runTagList(banzaiCli cli.Cli, options tagOptions, args []string) error {
+	orgID := input.GetOrganization(banzaiCli)
+
+	id, _, err := parseTagArgs(banzaiCli, orgID, options, args)
+	if err != nil {
+		return err
+	}
+
+	tags, _, err := banzaiCli.Client().SecretsApi.GetSecretTags(context.Background(), orgID, id)
+	if err != nil {
+		cli.LogAPIError("list secret tags", err, id)
+		return errors.WrapIf(utils.ConvertError(err), "could not list secret tags")
+	}
+
+	writeTags(banzaiCli, options, tags)
+
+	return nil
+}
+
+func writeTags(banzaiCli cli.Cli, options tagOptions, tags []string) {
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.SecretTagsWrite(banzaiCli.Out(), options.format, banzaiCli.Color(), tags)
+		return
+	}
+
+	rows := make([]tagRow, len(tags))
+	for i, tag := range tags {
+		rows[i] = tagRow{Tag: tag}
+	}
+
+	format.SecretTagsWrite(banzaiCli.Out(), options.format, banzaiCli.Color(), rows)
+}
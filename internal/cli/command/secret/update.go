@@ -0,0 +1,189 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type updateOptions struct {
+	file     string
+	name     string
+	id       string
+	validate string
+	format   string
+}
+
+// NewUpdateCommand creates a new cobra.Command for `banzai secret update`.
+func NewUpdateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "update ([--name=]NAME | --id=ID)",
+		Aliases: []string{"u", "rotate"},
+		Short:   "Update a secret",
+		Long:    "Update the values of a secret while keeping its ID, so the clusters and other resources referring to the secret keep working. The new values are read interactively, or from a JSON or YAML create request from stdin or a file.",
+		Example: `
+		$ banzai secret update mysecret
+		$ banzai secret update --id 0123456789abcdef -f secret.yaml --validate=false`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			options.format, _ = cmd.Flags().GetString("output")
+			if len(args) == 1 {
+				options.name = args[0]
+			}
+
+			return runUpdate(banzaiCli, options)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Secret descriptor file")
+	flags.StringVarP(&options.name, "name", "n", "", "Name of the secret to update")
+	flags.StringVarP(&options.id, "id", "i", "", "ID of the secret to update")
+	flags.StringVarP(&options.validate, "validate", "v", "", "Secret validation (true|false)")
+
+	return cmd
+}
+
+func runUpdate(banzaiCli cli.Cli, options updateOptions) error {
+	orgID := input.GetOrganization(banzaiCli)
+
+	id, err := getSecretID(banzaiCli, orgID, options.name, options.id)
+	if err != nil {
+		return err
+	}
+
+	secret, _, err := banzaiCli.Client().SecretsApi.GetSecret(context.Background(), orgID, id)
+	if err != nil {
+		cli.LogAPIError("get secret", err, id)
+		return errors.WrapIf(utils.ConvertError(err), "could not get secret")
+	}
+
+	out := &pipeline.CreateSecretRequest{}
+	if banzaiCli.Interactive() && options.file == "" {
+		if err := surveyUpdatedSecretValues(banzaiCli, secret, out); err != nil {
+			return err
+		}
+	} else if err := readFileAndValidate(options.file, out); err != nil {
+		return err
+	}
+
+	// keep the properties of the secret which are not updated
+	if out.Name == "" {
+		out.Name = secret.Name
+	}
+	if out.Type == "" {
+		out.Type = secret.Type
+	}
+	if out.Tags == nil {
+		out.Tags = secret.Tags
+	}
+	if out.Version == 0 {
+		out.Version = secret.Version
+	}
+
+	if out.Name != secret.Name || out.Type != secret.Type {
+		return errors.Errorf("the name and type of secret %q can't be changed", secret.Name)
+	}
+
+	log.Debugf("update secret request: %#v", out)
+	response, _, err := banzaiCli.Client().SecretsApi.UpdateSecrets(
+		context.Background(),
+		orgID,
+		id,
+		*out,
+		&pipeline.UpdateSecretsOpts{
+			Validate: getValidationFlag(options.validate),
+		},
+	)
+	if err != nil {
+		cli.LogAPIError("update secret", err, out.Name)
+		return errors.WrapIf(utils.ConvertError(err), "failed to update secret")
+	}
+
+	format.SecretWrite(banzaiCli.Out(), options.format, banzaiCli.Color(), response)
+
+	return nil
+}
+
+// surveyUpdatedSecretValues asks for the new values of the secret, keeping the current ones if left empty
+func surveyUpdatedSecretValues(banzaiCli cli.Cli, secret pipeline.SecretItem, out *pipeline.CreateSecretRequest) error {
+	out.Values = make(map[string]interface{}, len(secret.Values))
+	for key, value := range secret.Values {
+		out.Values[key] = value
+	}
+
+	if secret.Type == TypeGeneric {
+		for {
+			var key string
+			_ = survey.AskOne(
+				&survey.Input{
+					Message: "Key of field to set:",
+					Default: "skip",
+					Help:    "Leave empty to finish.",
+				},
+				&key,
+			)
+			if key == "skip" || key == "" {
+				return nil
+			}
+
+			var value string
+			if err := survey.AskOne(&survey.Password{Message: fmt.Sprintf("Value of %q:", key)}, &value, survey.WithValidator(survey.Required)); err != nil {
+				return errors.WrapIf(err, "failed to ask for value")
+			}
+			out.Values[key] = value
+		}
+	}
+
+	secretType, _, err := banzaiCli.Client().SecretsApi.GetSecretType(context.Background(), secret.Type)
+	if err != nil {
+		cli.LogAPIError("get secret type", err, secret.Type)
+		return errors.WrapIf(utils.ConvertError(err), "could not get secret type")
+	}
+
+	for _, field := range secretType.Fields {
+		var value string
+		if err := survey.AskOne(
+			&survey.Password{
+				Message: field.Name,
+				Help:    fmt.Sprintf("%s Leave empty to keep the current value.", field.Description),
+			},
+			&value,
+		); err != nil {
+			return errors.WrapIf(err, "failed to ask for value")
+		}
+
+		if value != "" {
+			out.Values[field.Name] = value
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/input"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// Exit codes of `banzai secret validate` in non-interactive mode
+const (
+	validateExitMissingField  = 2
+	validateExitInvalidField  = 3
+	validateExitServerInvalid = 4
+)
+
+type validateOptions struct {
+	file string
+	name string
+	id   string
+}
+
+// fieldProblem is a problem with a field of a secret found by local validation
+type fieldProblem struct {
+	field   string
+	missing bool
+	reason  string
+}
+
+// validationError is returned when a secret is found to be invalid
+type validationError struct {
+	error
+	exitCode int
+}
+
+// NewValidateCommand creates a new cobra.Command for `banzai secret validate`.
+func NewValidateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := validateOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "validate ([--name=]NAME | --id=ID | -f FILE)",
+		Aliases: []string{"v", "check"},
+		Short:   "Validate a secret",
+		Long: "Validate a secret. The fields of the secret are checked against the definition of its type locally, " +
+			"then Pipeline validates stored secrets by accessing the service they belong to (e.g. the cloud provider). " +
+			fmt.Sprintf("In non-interactive mode the command exits with code %d if a required field is missing, %d if a field is invalid, and %d if the server-side validation fails.",
+				validateExitMissingField, validateExitInvalidField, validateExitServerInvalid),
+		Example: `
+		$ banzai secret validate mysecret
+		$ banzai secret validate -f secret.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			if len(args) == 1 {
+				options.name = args[0]
+			}
+
+			err := runValidate(banzaiCli, options)
+
+			var verr validationError
+			if errors.As(err, &verr) && !banzaiCli.Interactive() {
+				log.Error(err)
+				os.Exit(verr.exitCode)
+			}
+
+			return err
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Secret descriptor file to validate")
+	flags.StringVarP(&options.name, "name", "n", "", "Name of the secret to validate")
+	flags.StringVarP(&options.id, "id", "i", "", "ID of the secret to validate")
+
+	return cmd
+}
+
+func runValidate(banzaiCli cli.Cli, options validateOptions) error {
+	orgID := input.GetOrganization(banzaiCli)
+
+	var id, secretType string
+	var values map[string]interface{}
+
+	if options.file != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.file)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		request := &pipeline.CreateSecretRequest{}
+		if err := validateCreateSecretRequest(raw); err != nil {
+			return validationError{errors.WrapIf(err, "failed to parse secret"), validateExitInvalidField}
+		}
+		if err := utils.Unmarshal(raw, request); err != nil {
+			return validationError{errors.WrapIf(err, "failed to unmarshal secret"), validateExitInvalidField}
+		}
+
+		secretType, values = request.Type, request.Values
+	} else {
+		var err error
+		if id, err = getSecretID(banzaiCli, orgID, options.name, options.id); err != nil {
+			return err
+		}
+
+		secret, _, err := banzaiCli.Client().SecretsApi.GetSecret(context.Background(), orgID, id)
+		if err != nil {
+			cli.LogAPIError("get secret", err, id)
+			return errors.WrapIf(utils.ConvertError(err), "could not get secret")
+		}
+
+		secretType, values = secret.Type, secret.Values
+	}
+
+	if secretType == "" {
+		return validationError{errors.New("secret type is not specified"), validateExitInvalidField}
+	}
+
+	typeDefinition, _, err := banzaiCli.Client().SecretsApi.GetSecretType(context.Background(), secretType)
+	if err != nil {
+		cli.LogAPIError("get secret type", err, secretType)
+		return errors.WrapIff(utils.ConvertError(err), "could not get definition of secret type %q", secretType)
+	}
+
+	if problems := checkSecretValues(secretType, typeDefinition.Fields, values); len(problems) > 0 {
+		exitCode := validateExitInvalidField
+		for _, problem := range problems {
+			if problem.missing {
+				exitCode = validateExitMissingField
+			}
+			log.Errorf("field %q: %s", problem.field, problem.reason)
+		}
+
+		return validationError{errors.Errorf("secret has %d invalid field(s)", len(problems)), exitCode}
+	}
+
+	if id == "" {
+		log.Info("the fields of the secret are valid; server-side validation is only available for stored secrets")
+		return nil
+	}
+
+	if resp, err := banzaiCli.Client().SecretsApi.ValidateSecret(context.Background(), orgID, id); err != nil {
+		cli.LogAPIError("validate secret", err, id)
+
+		// only a rejected secret is reported as invalid, other failures (e.g. authentication or an outage) are not
+		if resp != nil && resp.StatusCode == http.StatusBadRequest {
+			return validationError{errors.WrapIf(utils.ConvertError(err), "secret is invalid"), validateExitServerInvalid}
+		}
+
+		return errors.WrapIf(utils.ConvertError(err), "could not validate secret")
+	}
+
+	log.Info("secret is valid")
+
+	return nil
+}
+
+// checkSecretValues checks the values of a secret against the fields of its type.
+func checkSecretValues(secretType string, fields []pipeline.SecretTypeResponseFields, values map[string]interface{}) []fieldProblem {
+	var problems []fieldProblem
+
+	known := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		known[field.Name] = true
+
+		value, ok := values[field.Name]
+		if !ok || value == nil || value == "" {
+			if field.Required {
+				problems = append(problems, fieldProblem{field: field.Name, missing: true, reason: "required field is missing"})
+			}
+			continue
+		}
+
+		if _, ok := value.(string); !ok {
+			problems = append(problems, fieldProblem{field: field.Name, reason: fmt.Sprintf("value must be a string, got %T", value)})
+		}
+	}
+
+	// generic secrets may contain any field
+	if secretType == TypeGeneric {
+		return problems
+	}
+
+	var unknown []string
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+
+	for _, name := range unknown {
+		problems = append(problems, fieldProblem{field: name, reason: fmt.Sprintf("field is not defined by secret type %q", secretType)})
+	}
+
+	return problems
+}
@@ -0,0 +1,71 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestCheckSecretValues(t *testing.T) {
+	fields := []pipeline.SecretTypeResponseFields{
+		{Name: "username", Required: true},
+		{Name: "password", Required: true},
+		{Name: "comment"},
+	}
+
+	tests := []struct {
+		name       string
+		secretType string
+		values     map[string]interface{}
+		expected   []fieldProblem
+	}{
+		{
+			name:       "valid",
+			secretType: "password",
+			values:     map[string]interface{}{"username": "admin", "password": "secret"},
+		},
+		{
+			name:       "missing",
+			secretType: "password",
+			values:     map[string]interface{}{"username": "admin", "password": ""},
+			expected:   []fieldProblem{{field: "password", missing: true, reason: "required field is missing"}},
+		},
+		{
+			name:       "invalid",
+			secretType: "password",
+			values:     map[string]interface{}{"username": "admin", "password": 42.0, "extra": "x"},
+			expected: []fieldProblem{
+				{field: "password", reason: "value must be a string, got float64"},
+				{field: "extra", reason: `field is not defined by secret type "password"`},
+			},
+		},
+		{
+			name:       "generic",
+			secretType: TypeGeneric,
+			values:     map[string]interface{}{"username": "admin", "password": "secret", "extra": "x"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, checkSecretValues(test.secretType, fields, test.values))
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotguide
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+// Question types of spotguides which need special handling
+const (
+	questionTypeBoolean  = "boolean"
+	questionTypeInt      = "int"
+	questionTypeNumber   = "number"
+	questionTypePassword = "password"
+)
+
+// questionKey returns the key under which the answer to a question is stored in the pipeline values.
+func questionKey(question pipeline.SpotguideOption) string {
+	if question.Key != "" {
+		return question.Key
+	}
+
+	return question.Name
+}
+
+// collectAnswers returns the answers to the questions of a spotguide, keyed by the keys of the questions.
+// Answers given in advance are used as they are; the others are asked in interactive mode, or the defaults are used otherwise.
+func collectAnswers(questions []pipeline.SpotguideOption, given map[string]interface{}, interactive bool) (map[string]interface{}, error) {
+	answers := make(map[string]interface{}, len(questions))
+
+	for _, question := range questions {
+		key := questionKey(question)
+
+		if answer, ok := given[key]; ok {
+			answers[key] = answer
+			continue
+		}
+
+		if question.Readonly || !interactive {
+			if question.Default == "" && isNumeric(question) {
+				return nil, errors.Errorf("no answer is given to question %q", key)
+			}
+
+			answer, err := defaultAnswer(question)
+			if err != nil {
+				return nil, errors.WrapIff(err, "invalid default value of question %q", key)
+			}
+			answers[key] = answer
+			continue
+		}
+
+		answer, err := askQuestion(question)
+		if err != nil {
+			return nil, err
+		}
+		answers[key] = answer
+	}
+
+	return answers, nil
+}
+
+func askQuestion(question pipeline.SpotguideOption) (interface{}, error) {
+	message := fmt.Sprintf("%s:", question.Name)
+
+	switch strings.ToLower(question.Type) {
+	case questionTypeBoolean:
+		answer, _ := strconv.ParseBool(question.Default)
+		if err := survey.AskOne(&survey.Confirm{Message: message, Help: question.Info, Default: answer}, &answer); err != nil {
+			return nil, errors.WrapIff(err, "failed to get answer to question %q", question.Name)
+		}
+
+		return answer, nil
+
+	case questionTypePassword:
+		var answer string
+		if err := survey.AskOne(&survey.Password{Message: message, Help: question.Info}, &answer, survey.WithValidator(survey.Required)); err != nil {
+			return nil, errors.WrapIff(err, "failed to get answer to question %q", question.Name)
+		}
+
+		return answer, nil
+
+	default:
+		var answer string
+		validator := func(v interface{}) error {
+			_, err := convertAnswer(question, v.(string))
+			return err
+		}
+		if err := survey.AskOne(&survey.Input{Message: message, Help: question.Info, Default: question.Default}, &answer, survey.WithValidator(validator)); err != nil {
+			return nil, errors.WrapIff(err, "failed to get answer to question %q", question.Name)
+		}
+
+		return convertAnswer(question, answer)
+	}
+}
+
+// defaultAnswer returns the default answer to a question.
+// An empty default means false to boolean questions and an empty text to textual ones.
+func defaultAnswer(question pipeline.SpotguideOption) (interface{}, error) {
+	if question.Default == "" && strings.EqualFold(question.Type, questionTypeBoolean) {
+		return false, nil
+	}
+
+	return convertAnswer(question, question.Default)
+}
+
+// isNumeric tells whether the answer to a question must be a number, which an empty text can't stand for.
+func isNumeric(question pipeline.SpotguideOption) bool {
+	questionType := strings.ToLower(question.Type)
+	return questionType == questionTypeInt || questionType == questionTypeNumber
+}
+
+// convertAnswer converts a textual answer to the type of the question.
+func convertAnswer(question pipeline.SpotguideOption, answer string) (interface{}, error) {
+	switch strings.ToLower(question.Type) {
+	case questionTypeBoolean:
+		return strconv.ParseBool(answer)
+	case questionTypeInt:
+		return strconv.Atoi(answer)
+	case questionTypeNumber:
+		return strconv.ParseFloat(answer, 64)
+	default:
+		return answer, nil
+	}
+}
+
+// setValue sets a value in a nested map by a dot separated key, creating the intermediate maps as needed.
+func setValue(values map[string]interface{}, key string, value interface{}) error {
+	parts := strings.Split(key, ".")
+	current := values
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part]
+		if !ok {
+			nextMap := map[string]interface{}{}
+			current[part] = nextMap
+			current = nextMap
+			continue
+		}
+
+		nextMap, ok := next.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("cannot set %q, because %q is not a map", key, part)
+		}
+		current = nextMap
+	}
+
+	current[parts[len(parts)-1]] = value
+	return nil
+}
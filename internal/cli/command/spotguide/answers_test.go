@@ -0,0 +1,79 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotguide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestCollectAnswers(t *testing.T) {
+	questions := []pipeline.SpotguideOption{
+		{Name: "Replicas", Type: "int", Default: "2", Key: "deploy.replicas"},
+		{Name: "Monitoring", Type: "boolean", Default: "true", Key: "deploy.monitoring"},
+		{Name: "domain", Type: "string"},
+	}
+
+	t.Run("defaults and given answers", func(t *testing.T) {
+		answers, err := collectAnswers(questions, map[string]interface{}{"domain": "example.org"}, false)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"deploy.replicas":   2,
+			"deploy.monitoring": true,
+			"domain":            "example.org",
+		}, answers)
+	})
+
+	t.Run("empty defaults", func(t *testing.T) {
+		questions := []pipeline.SpotguideOption{
+			{Name: "Ingress", Type: "boolean", Key: "ingress.enabled"},
+			{Name: "domain", Type: "string"},
+			{Name: "Version", Type: "string", Readonly: true},
+		}
+
+		answers, err := collectAnswers(questions, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"ingress.enabled": false,
+			"domain":          "",
+			"Version":         "",
+		}, answers)
+	})
+
+	t.Run("missing answer", func(t *testing.T) {
+		_, err := collectAnswers([]pipeline.SpotguideOption{{Name: "Replicas", Type: "int"}}, nil, false)
+		require.Error(t, err)
+	})
+}
+
+func TestSetValue(t *testing.T) {
+	values := map[string]interface{}{
+		"deploy": map[string]interface{}{"image": "nginx"},
+		"domain": "example.org",
+	}
+
+	require.NoError(t, setValue(values, "deploy.replicas", 2))
+	require.NoError(t, setValue(values, "monitoring.enabled", true))
+	require.Error(t, setValue(values, "domain.name", "example.org"))
+
+	require.Equal(t, map[string]interface{}{
+		"deploy":     map[string]interface{}{"image": "nginx", "replicas": 2},
+		"monitoring": map[string]interface{}{"enabled": true},
+		"domain":     "example.org",
+	}, values)
+}
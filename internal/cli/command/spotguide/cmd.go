@@ -0,0 +1,39 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotguide
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewSpotguideCommand returns a cobra command for `spotguide` subcommands.
+func NewSpotguideCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "spotguide",
+		Aliases: []string{"spotguides", "sg"},
+		Short:   "Browse and launch spotguides",
+	}
+
+	cmd.AddCommand(
+		NewLaunchCommand(banzaiCli),
+		NewListCommand(banzaiCli),
+		NewShowCommand(banzaiCli),
+		NewSyncCommand(banzaiCli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,211 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotguide
+
+import (
+	"context"
+	"encoding/json"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type launchOptions struct {
+	file             string
+	clusterFile      string
+	version          string
+	repoOrganization string
+	repoName         string
+	repoPrivate      bool
+}
+
+// launchRequest is the answers file of a spotguide launch: a launch request and the answers to the questions of the spotguide keyed by their keys.
+type launchRequest struct {
+	pipeline.LaunchSpotguidesRequest
+	Answers map[string]interface{} `json:"answers,omitempty"`
+}
+
+// NewLaunchCommand creates a new cobra.Command for `banzai spotguide launch`.
+func NewLaunchCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := launchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "launch [NAME]",
+		Short: "Launch a spotguide",
+		Long: "Launch a spotguide: create a repository from it, and the cluster to deploy it to. " +
+			"In interactive mode banzai CLI asks the questions of the spotguide. Otherwise the answers are read from the answers file, which is a launch request with an additional \"answers\" map keyed by the keys of the questions.",
+		Example: `
+		$ banzai spotguide launch spotguide-nodejs-mongodb --repo-organization myorg --repo-name myapp --cluster-file cluster.yaml
+		$ banzai spotguide launch spotguide-nodejs-mongodb --no-interactive -f answers.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runLaunch(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.file, "file", "f", "", "Answers file")
+	flags.StringVar(&options.clusterFile, "cluster-file", "", "Descriptor file of the cluster to create for the spotguide")
+	flags.StringVar(&options.version, "version", "", "Version of the spotguide (the latest one if not given)")
+	flags.StringVar(&options.repoOrganization, "repo-organization", "", "Organization of the repository to create")
+	flags.StringVar(&options.repoName, "repo-name", "", "Name of the repository to create")
+	flags.BoolVar(&options.repoPrivate, "repo-private", false, "Create a private repository")
+
+	return cmd
+}
+
+func runLaunch(banzaiCli cli.Cli, options launchOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var request launchRequest
+	if options.file != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.file)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal answers")
+		}
+	}
+
+	if len(args) > 0 {
+		request.SpotguideName = args[0]
+	}
+	if options.version != "" {
+		request.SpotguideVersion = options.version
+	}
+	if options.repoOrganization != "" {
+		request.RepoOrganization = options.repoOrganization
+	}
+	if options.repoName != "" {
+		request.RepoName = options.repoName
+	}
+	if options.repoPrivate {
+		request.RepoPrivate = true
+	}
+
+	if options.clusterFile != "" {
+		filename, raw, err := utils.ReadFileOrStdin(options.clusterFile)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request.Cluster); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal cluster descriptor")
+		}
+	}
+
+	spotguide, err := getSpotguide(banzaiCli, orgID, request.SpotguideName)
+	if err != nil {
+		return err
+	}
+	request.SpotguideName = spotguide.Name
+
+	if err := askMissing(banzaiCli, &request); err != nil {
+		return err
+	}
+
+	answers, err := collectAnswers(spotguide.Questions, request.Answers, banzaiCli.Interactive())
+	if err != nil {
+		return err
+	}
+
+	if request.Pipeline == nil {
+		request.Pipeline = map[string]interface{}{}
+	}
+	for key, answer := range answers {
+		if err := setValue(request.Pipeline, key, answer); err != nil {
+			return err
+		}
+	}
+
+	if banzaiCli.Interactive() {
+		content, err := json.MarshalIndent(request.LaunchSpotguidesRequest, "", "  ")
+		if err != nil {
+			return errors.WrapIf(err, "failed to marshal launch request")
+		}
+		log.Debugf("launch spotguide request: %s", content)
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: "Do you want to launch the spotguide?", Default: true}, &confirmed)
+		if !confirmed {
+			return errors.New("launch cancelled")
+		}
+	}
+
+	if _, err := banzaiCli.Client().SpotguidesApi.LaunchSpotguide(context.Background(), orgID, request.LaunchSpotguidesRequest); err != nil {
+		cli.LogAPIError("launch spotguide", err, request.LaunchSpotguidesRequest)
+		return errors.WrapIf(utils.ConvertError(err), "failed to launch spotguide")
+	}
+
+	log.Infof("spotguide %q is being launched to repository %s/%s", spotguide.Name, request.RepoOrganization, request.RepoName)
+
+	return nil
+}
+
+// askMissing asks for the repository and cluster settings of a launch request which are not given in advance.
+func askMissing(banzaiCli cli.Cli, request *launchRequest) error {
+	if !banzaiCli.Interactive() {
+		switch {
+		case request.RepoOrganization == "":
+			return errors.New("repository organization must be specified with --repo-organization")
+		case request.RepoName == "":
+			return errors.New("repository name must be specified with --repo-name")
+		case request.Cluster.Name == "":
+			return errors.New("cluster must be specified with --cluster-file")
+		}
+
+		return nil
+	}
+
+	if request.RepoOrganization == "" {
+		if err := survey.AskOne(&survey.Input{Message: "Repository organization:"}, &request.RepoOrganization, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get repository organization")
+		}
+	}
+
+	if request.RepoName == "" {
+		if err := survey.AskOne(&survey.Input{Message: "Repository name:"}, &request.RepoName, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get repository name")
+		}
+	}
+
+	if request.Cluster.Name == "" {
+		var filename string
+		if err := survey.AskOne(&survey.Input{Message: "Cluster descriptor file:"}, &filename, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get cluster descriptor file")
+		}
+
+		_, raw, err := utils.ReadFileOrStdin(filename)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to read", "filename", filename)
+		}
+
+		if err := utils.Unmarshal(raw, &request.Cluster); err != nil {
+			return errors.WrapIf(err, "failed to unmarshal cluster descriptor")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotguide
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type showOptions struct {
+	readme bool
+}
+
+// NewShowCommand creates a new cobra.Command for `banzai spotguide show`.
+func NewShowCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := showOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "show [NAME]",
+		Aliases: []string{"get", "g"},
+		Short:   "Show details of a spotguide",
+		Long:    "Show details of a spotguide, including the questions asked when it is launched.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runShow(banzaiCli, options, args)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.readme, "readme", false, "Show the readme of the spotguide")
+
+	return cmd
+}
+
+func runShow(banzaiCli cli.Cli, options showOptions, args []string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	spotguide, err := getSpotguide(banzaiCli, orgID, name)
+	if err != nil {
+		return err
+	}
+
+	format.SpotguideWrite(banzaiCli, spotguide)
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		return nil
+	}
+
+	if len(spotguide.Questions) > 0 {
+		fmt.Fprintln(banzaiCli.Out())
+		format.SpotguideQuestionsWrite(banzaiCli, spotguide.Questions)
+	}
+
+	if options.readme && spotguide.Readme != "" {
+		fmt.Fprintf(banzaiCli.Out(), "\nReadme\n----\n%s\n", spotguide.Readme)
+	}
+
+	return nil
+}
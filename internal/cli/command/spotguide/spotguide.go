@@ -0,0 +1,63 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotguide
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// getSpotguide gets a spotguide by its name. If name is empty, the user is asked to select one in interactive mode.
+func getSpotguide(banzaiCli cli.Cli, orgID int32, name string) (pipeline.SpotguideDetailsResponse, error) {
+	client := banzaiCli.Client()
+
+	if name == "" {
+		if !banzaiCli.Interactive() {
+			return pipeline.SpotguideDetailsResponse{}, errors.New("no spotguide is selected; specify the name of the spotguide")
+		}
+
+		spotguides, _, err := client.SpotguidesApi.ListSpotguides(context.Background(), orgID)
+		if err != nil {
+			cli.LogAPIError("list spotguides", err, orgID)
+			return pipeline.SpotguideDetailsResponse{}, errors.WrapIf(err, "could not list spotguides")
+		}
+
+		if len(spotguides) == 0 {
+			return pipeline.SpotguideDetailsResponse{}, errors.New("there are no spotguides available")
+		}
+
+		names := make([]string, len(spotguides))
+		for i, spotguide := range spotguides {
+			names[i] = spotguide.Name
+		}
+
+		if err := survey.AskOne(&survey.Select{Message: "Spotguide:", Options: names}, &name, survey.WithValidator(survey.Required)); err != nil {
+			return pipeline.SpotguideDetailsResponse{}, errors.WrapIf(err, "failed to select a spotguide")
+		}
+	}
+
+	spotguide, _, err := client.SpotguidesApi.GetSpotguideDetail(context.Background(), orgID, name)
+	if err != nil {
+		cli.LogAPIError("get spotguide", err, name)
+		return spotguide, errors.WrapIff(err, "could not get spotguide %q", name)
+	}
+
+	return spotguide, nil
+}
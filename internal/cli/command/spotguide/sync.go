@@ -0,0 +1,54 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotguide
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// NewSyncCommand creates a new cobra.Command for `banzai spotguide sync`.
+func NewSyncCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Synchronize spotguides from their repositories",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runSync(banzaiCli)
+		},
+	}
+
+	return cmd
+}
+
+func runSync(banzaiCli cli.Cli) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	if _, err := banzaiCli.Client().SpotguidesApi.UpdateSpotguides(context.Background(), orgID); err != nil {
+		cli.LogAPIError("sync spotguides", err, orgID)
+		return errors.WrapIf(err, "failed to synchronize spotguides")
+	}
+
+	log.Info("spotguides are being synchronized")
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+type createOptions struct {
+	expires     string
+	virtualUser string
+}
+
+// NewCreateCommand creates a new cobra.Command for `banzai token create`.
+func NewCreateCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := createOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "create [NAME]",
+		Aliases: []string{"c", "new"},
+		Short:   "Create a personal access token",
+		Long:    "Create a personal access token. Tokens of virtual users can be used by CI robots, which should not act in the name of a real user. The token is shown only once.",
+		Example: `
+		$ banzai token create ci --expires 90d
+		$ banzai token create deployer --virtual-user myorg/ci-robot --expires 2021-01-31`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runCreate(banzaiCli, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.expires, "expires", "", "Expiry of the token as a duration (like 720h or 30d) or a date (like 2021-01-31); never expires if not given")
+	flags.StringVar(&options.virtualUser, "virtual-user", "", "Create the token for the given virtual user")
+
+	return cmd
+}
+
+func runCreate(banzaiCli cli.Cli, options createOptions, args []string) error {
+	var request pipeline.TokenCreateRequest
+
+	if len(args) > 0 {
+		request.Name = args[0]
+	} else if banzaiCli.Interactive() {
+		if err := survey.AskOne(&survey.Input{Message: "Token name:"}, &request.Name, survey.WithValidator(survey.Required)); err != nil {
+			return errors.WrapIf(err, "failed to get token name")
+		}
+	} else {
+		return errors.New("NAME argument must be specified")
+	}
+
+	request.VirtualUser = options.virtualUser
+
+	if options.expires != "" {
+		expiresAt, err := parseExpiry(options.expires, time.Now())
+		if err != nil {
+			return err
+		}
+		request.ExpiresAt = &expiresAt
+	}
+
+	log.Debugf("create token request: %#v", request)
+	token, _, err := banzaiCli.Client().AuthApi.CreateToken(context.Background(), request)
+	if err != nil {
+		cli.LogAPIError("create token", err, request)
+		return errors.WrapIf(utils.ConvertError(err), "failed to create token")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.TokenWrite(banzaiCli, token)
+		return nil
+	}
+
+	log.Infof("token %q created; store it in a safe place, it won't be shown again", token.Name)
+	fmt.Fprintln(banzaiCli.Out(), token.Token)
+
+	return nil
+}
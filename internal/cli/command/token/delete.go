@@ -0,0 +1,143 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/dgrijalva/jwt-go"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewDeleteCommand creates a new cobra.Command for `banzai token delete`.
+func NewDeleteCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete [ID|NAME]",
+		Aliases: []string{"d", "rm", "revoke"},
+		Short:   "Delete a personal access token",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var ref string
+			if len(args) > 0 {
+				ref = args[0]
+			}
+
+			return runDelete(banzaiCli, ref)
+		},
+	}
+
+	return cmd
+}
+
+func runDelete(banzaiCli cli.Cli, ref string) error {
+	token, err := getToken(banzaiCli, ref)
+	if err != nil {
+		return err
+	}
+
+	current := currentTokenID() == token.Id
+
+	if banzaiCli.Interactive() {
+		message := "Do you want to DELETE the token?"
+		if current {
+			message = "The token is used by banzai CLI, you will have to log in again. Do you want to DELETE the token?"
+		}
+
+		confirmed := false
+		survey.AskOne(&survey.Confirm{Message: message}, &confirmed)
+		if !confirmed {
+			return errors.New("deletion cancelled")
+		}
+	}
+
+	if _, err := banzaiCli.Client().AuthApi.DeleteToken(context.Background(), token.Id); err != nil {
+		cli.LogAPIError("delete token", err, token.Id)
+		return errors.WrapIf(utils.ConvertError(err), "failed to delete token")
+	}
+
+	log.Infof("token %q (%s) deleted", token.Name, token.Id)
+	if current {
+		log.Warn("the deleted token was used by banzai CLI, log in again with `banzai login`")
+	}
+
+	return nil
+}
+
+// getToken finds a token by its ID or name. If ref is empty, the user is asked to select one in interactive mode.
+func getToken(banzaiCli cli.Cli, ref string) (tokenItem, error) {
+	if ref == "" && !banzaiCli.Interactive() {
+		return tokenItem{}, errors.New("no token is selected; specify the ID or name of the token")
+	}
+
+	tokens, err := listTokens(banzaiCli)
+	if err != nil {
+		return tokenItem{}, err
+	}
+
+	if ref == "" {
+		if len(tokens) == 0 {
+			return tokenItem{}, errors.New("there are no tokens")
+		}
+
+		options := make([]string, len(tokens))
+		for i, token := range tokens {
+			options[i] = fmt.Sprintf("%s (%s)", token.Name, token.Id)
+		}
+
+		var selected int
+		if err := survey.AskOne(&survey.Select{Message: "Token:", Options: options}, &selected); err != nil {
+			return tokenItem{}, errors.WrapIf(err, "failed to select a token")
+		}
+
+		return tokens[selected], nil
+	}
+
+	var matches []tokenItem
+	for _, token := range tokens {
+		if token.Id == ref {
+			return token, nil
+		}
+		if token.Name == ref {
+			matches = append(matches, token)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return tokenItem{}, errors.Errorf("could not find token %q", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return tokenItem{}, errors.Errorf("there are %d tokens named %q, specify the ID of the token", len(matches), ref)
+	}
+}
+
+// currentTokenID returns the ID of the token used by the CLI, if any.
+func currentTokenID() string {
+	claims := jwt.StandardClaims{}
+	_, _ = jwt.ParseWithClaims(viper.GetString("pipeline.token"), &claims, nil)
+
+	return claims.Id
+}
@@ -0,0 +1,107 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+)
+
+type listOptions struct {
+	warnWithin time.Duration
+}
+
+type tokenRow struct {
+	Id        string
+	Name      string
+	CreatedAt string
+	ExpiresAt string
+	ExpiresIn string
+}
+
+// NewListCommand creates a new cobra.Command for `banzai token list`.
+func NewListCommand(banzaiCli cli.Cli) *cobra.Command {
+	options := listOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List personal access tokens",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runList(banzaiCli, options)
+		},
+	}
+
+	cmd.Flags().DurationVar(&options.warnWithin, "warn-within", 7*24*time.Hour, "Warn about tokens expiring within this duration")
+
+	return cmd
+}
+
+func runList(banzaiCli cli.Cli, options listOptions) error {
+	tokens, err := listTokens(banzaiCli)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, token := range tokens {
+		if token.ExpiresAt == nil {
+			continue
+		}
+
+		if left := token.ExpiresAt.Sub(now); left <= 0 {
+			log.Warnf("token %q (%s) has expired", token.Name, token.Id)
+		} else if left < options.warnWithin {
+			log.Warnf("token %q (%s) expires in %s", token.Name, token.Id, left.Round(time.Minute))
+		}
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.TokensWrite(banzaiCli, tokens)
+		return nil
+	}
+
+	rows := make([]tokenRow, len(tokens))
+	for i, token := range tokens {
+		rows[i] = tokenRow{
+			Id:        token.Id,
+			Name:      token.Name,
+			CreatedAt: token.CreatedAt,
+			ExpiresAt: "never",
+		}
+
+		if token.ExpiresAt != nil {
+			rows[i].ExpiresAt = token.ExpiresAt.Format(time.RFC3339)
+			if left := token.ExpiresAt.Sub(now); left > 0 {
+				rows[i].ExpiresIn = left.Round(time.Minute).String()
+			} else {
+				rows[i].ExpiresIn = "expired"
+			}
+		}
+	}
+
+	format.TokensWrite(banzaiCli, rows)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+)
+
+// tokenItem is an item of the token list returned by Pipeline.
+// The generated client does not contain the expiry of the tokens, so the list is requested directly.
+type tokenItem struct {
+	Id        string     `json:"id"`
+	Name      string     `json:"name"`
+	CreatedAt string     `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+func listTokens(banzaiCli cli.Cli) ([]tokenItem, error) {
+	config := banzaiCli.Client().GetConfig()
+
+	httpRequest, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/tokens", config.BasePath), nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create token list request")
+	}
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("User-Agent", config.UserAgent)
+
+	response, err := config.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list tokens")
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to read token list")
+	}
+	log.Debugf("list tokens response: %s", body)
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		var pipelineError pipeline.CommonError
+		if err := json.Unmarshal(body, &pipelineError); err == nil && pipelineError.Message != "" {
+			return nil, errors.Errorf("failed to list tokens: %s", pipelineError.Message)
+		}
+
+		return nil, errors.Errorf("failed to list tokens: %s", response.Status)
+	}
+
+	var tokens []tokenItem
+	if err := json.Unmarshal(body, &tokens); err != nil {
+		return nil, errors.WrapIf(err, "failed to unmarshal token list")
+	}
+
+	return tokens, nil
+}
+
+// parseExpiry parses the expiry of a token given either as a duration (like 720h or 30d) or as a date (like 2021-01-31 or RFC 3339).
+func parseExpiry(value string, now time.Time) (time.Time, error) {
+	if strings.HasSuffix(value, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(value, "d")); err == nil && days > 0 {
+			return now.AddDate(0, 0, days), nil
+		}
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil {
+		if duration <= 0 {
+			return time.Time{}, errors.Errorf("expiry duration must be positive: %q", value)
+		}
+
+		return now.Add(duration), nil
+	}
+
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if expiresAt, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			if !expiresAt.After(now) {
+				return time.Time{}, errors.Errorf("expiry must be in the future: %q", value)
+			}
+
+			return expiresAt, nil
+		}
+	}
+
+	return time.Time{}, errors.Errorf("invalid expiry %q, expected a duration like 720h or 30d, or a date like 2021-01-31", value)
+}
@@ -0,0 +1,54 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Time
+		err      bool
+	}{
+		{value: "720h", expected: now.Add(720 * time.Hour)},
+		{value: "30d", expected: time.Date(2020, 3, 31, 12, 0, 0, 0, time.UTC)},
+		{value: "2020-06-01", expected: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2020-06-01T10:00:00Z", expected: time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)},
+		{value: "2020-01-01", err: true},
+		{value: "-1h", err: true},
+		{value: "soon", err: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.value, func(t *testing.T) {
+			expiresAt, err := parseExpiry(test.value, now)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, test.expected.Equal(expiresAt), "expected %s, got %s", test.expected, expiresAt)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewGetCommand creates a new cobra.Command for `banzai user get`.
+func NewGetCommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get ID|LOGIN",
+		Aliases: []string{"g", "show"},
+		Short:   "Get details of a user of the current organization",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runGet(banzaiCli, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runGet(banzaiCli cli.Cli, ref string) error {
+	orgID := banzaiCli.Context().OrganizationID()
+
+	user, err := getUser(banzaiCli, orgID, ref)
+	if err != nil {
+		return err
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.UserWrite(banzaiCli, user)
+		return nil
+	}
+
+	orgName, err := getOrganizationName(banzaiCli, orgID)
+	if err != nil {
+		return err
+	}
+
+	format.UserWrite(banzaiCli, NewRows([]pipeline.User{user}, orgName)[0])
+
+	return nil
+}
+
+// getUser finds a user of the organization by ID or login name.
+func getUser(banzaiCli cli.Cli, orgID int32, ref string) (pipeline.User, error) {
+	if id, err := strconv.ParseInt(ref, 10, 32); err == nil {
+		user, _, err := banzaiCli.Client().UsersApi.GetUsers(context.Background(), orgID, int32(id))
+		if err != nil {
+			cli.LogAPIError("get user", err, id)
+			return pipeline.User{}, errors.WrapIf(utils.ConvertError(err), "failed to get user")
+		}
+
+		return user, nil
+	}
+
+	users, _, err := banzaiCli.Client().UsersApi.ListUsers(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("list users", err, orgID)
+		return pipeline.User{}, errors.WrapIf(utils.ConvertError(err), "failed to list users")
+	}
+
+	for _, user := range users {
+		if user.Login == ref {
+			return user, nil
+		}
+	}
+
+	return pipeline.User{}, errors.Errorf("could not find user %q in the organization", ref)
+}
@@ -0,0 +1,101 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// Row is a user as shown in the default output format.
+type Row struct {
+	Id          int32
+	Login       string
+	Name        string
+	Email       string
+	Role        string
+	LoginMethod string
+	CreatedAt   string
+}
+
+// NewRows converts users to rows showing their role in the given organization.
+func NewRows(users []pipeline.User, orgName string) []Row {
+	rows := make([]Row, len(users))
+	for i, user := range users {
+		rows[i] = Row{
+			Id:          user.Id,
+			Login:       user.Login,
+			Name:        user.Name,
+			Email:       user.Email,
+			Role:        Role(user, orgName),
+			LoginMethod: LoginMethod(user),
+			CreatedAt:   user.CreatedAt,
+		}
+	}
+
+	return rows
+}
+
+// Role returns the role of the user in the given organization.
+// Pipeline returns the memberships of a user as a map of organization names to roles.
+func Role(user pipeline.User, orgName string) string {
+	membership, ok := user.Organizations[orgName]
+	if !ok {
+		return ""
+	}
+
+	switch role := membership.(type) {
+	case string:
+		return role
+	case map[string]interface{}:
+		if role, ok := role["role"].(string); ok {
+			return role
+		}
+	}
+
+	return fmt.Sprint(membership)
+}
+
+// LoginMethod returns how the user logs in to Pipeline.
+// Virtual users (with logins like org/name) can only use tokens, other users log in through the OIDC provider,
+// either with their GitHub account (which grants Pipeline access to GitHub) or with another connector.
+func LoginMethod(user pipeline.User) string {
+	switch {
+	case strings.Contains(user.Login, "/"):
+		return "token"
+	case user.GitHubTokenSet:
+		return "github"
+	default:
+		return "oidc"
+	}
+}
+
+// getOrganizationName returns the name of the organization with the given ID.
+func getOrganizationName(banzaiCli cli.Cli, orgID int32) (string, error) {
+	org, _, err := banzaiCli.Client().OrganizationsApi.GetOrg(context.Background(), orgID)
+	if err != nil {
+		cli.LogAPIError("get organization", err, orgID)
+		return "", errors.WrapIf(utils.ConvertError(err), "failed to get organization")
+	}
+
+	return org.Name, nil
+}
@@ -0,0 +1,49 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+)
+
+func TestRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		orgs     map[string]interface{}
+		expected string
+	}{
+		{name: "string role", orgs: map[string]interface{}{"acme": "admin"}, expected: "admin"},
+		{name: "object role", orgs: map[string]interface{}{"acme": map[string]interface{}{"role": "member"}}, expected: "member"},
+		{name: "other organization", orgs: map[string]interface{}{"other": "admin"}, expected: ""},
+		{name: "no organizations", expected: ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, Role(pipeline.User{Organizations: test.orgs}, "acme"))
+		})
+	}
+}
+
+func TestLoginMethod(t *testing.T) {
+	require.Equal(t, "token", LoginMethod(pipeline.User{Login: "acme/ci-robot"}))
+	require.Equal(t, "github", LoginMethod(pipeline.User{Login: "jdoe", GitHubTokenSet: true}))
+	require.Equal(t, "oidc", LoginMethod(pipeline.User{Login: "jdoe"}))
+}
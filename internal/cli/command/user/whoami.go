@@ -0,0 +1,69 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/format"
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NewWhoAmICommand creates a new cobra.Command for `banzai whoami`.
+func NewWhoAmICommand(banzaiCli cli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the current user",
+		Long:  "Show the user banzai CLI is logged in as, and its role in the selected organization.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return runWhoAmI(banzaiCli)
+		},
+	}
+
+	return cmd
+}
+
+func runWhoAmI(banzaiCli cli.Cli) error {
+	user, _, err := banzaiCli.Client().UsersApi.GetCurrentUser(context.Background())
+	if err != nil {
+		cli.LogAPIError("get current user", err, nil)
+		return errors.WrapIf(utils.ConvertError(err), "failed to get current user")
+	}
+
+	if banzaiCli.OutputFormat() != output.OutputFormatDefault {
+		format.UserWrite(banzaiCli, user)
+		return nil
+	}
+
+	var orgName string
+	if orgID := banzaiCli.Context().OrganizationID(); orgID != 0 {
+		if orgName, err = getOrganizationName(banzaiCli, orgID); err != nil {
+			return err
+		}
+	}
+
+	format.UserWrite(banzaiCli, NewRows([]pipeline.User{user}, orgName)[0])
+
+	return nil
+}
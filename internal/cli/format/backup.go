@@ -0,0 +1,58 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// BackupWrite writes a backup to the output.
+func BackupWrite(context formatContext, data interface{}) {
+	backupsWrite(context, []interface{}{data}, []string{"Id", "Name", "Status", "Cloud", "Distribution", "Ttl", "StartAt", "ExpireAt"})
+}
+
+// BackupsWrite writes a backup list to the output.
+func BackupsWrite(context formatContext, data interface{}) {
+	backupsWrite(context, data, []string{"Id", "Name", "Status", "StartAt", "ExpireAt"})
+}
+
+// OrganizationBackupsWrite writes a backup list of several clusters to the output.
+func OrganizationBackupsWrite(context formatContext, data interface{}) {
+	backupsWrite(context, data, []string{"Id", "Name", "ClusterId", "Status", "StartAt", "ExpireAt"})
+}
+
+// BackupInventoryWrite writes a per-cluster backup inventory to the output.
+func BackupInventoryWrite(context formatContext, data interface{}) {
+	backupsWrite(context, data, []string{"ClusterId", "ClusterName", "BackupCount", "LastSuccessful", "Age", "Stale"})
+}
+
+// BackupServiceStatusWrite writes the status of the backup service of a cluster to the output.
+func BackupServiceStatusWrite(context formatContext, data interface{}) {
+	backupsWrite(context, []interface{}{data}, []string{"Enabled"})
+}
+
+func backupsWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	if err := output.Output(ctx, data); err != nil {
+		log.Fatal(err)
+	}
+}
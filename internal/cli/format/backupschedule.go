@@ -0,0 +1,25 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+// BackupScheduleWrite writes a backup schedule to the output.
+func BackupScheduleWrite(context formatContext, data interface{}) {
+	backupsWrite(context, []interface{}{data}, []string{"Uid", "Name", "Schedule", "Ttl", "Status", "LastBackup"})
+}
+
+// BackupSchedulesWrite writes a backup schedule list to the output.
+func BackupSchedulesWrite(context formatContext, data interface{}) {
+	backupsWrite(context, data, []string{"Name", "Schedule", "Ttl", "Status", "LastBackup"})
+}
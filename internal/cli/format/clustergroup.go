@@ -0,0 +1,73 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClusterGroupWrite writes a cluster group to the output.
+func ClusterGroupWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, []interface{}{data}, []string{"Id", "Uid", "Name", "EnabledFeatures"})
+}
+
+// ClusterGroupsWrite writes a cluster group list to the output.
+func ClusterGroupsWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, data, []string{"Id", "Name", "EnabledFeatures"})
+}
+
+// ClusterGroupMembersWrite writes the member clusters of a cluster group to the output.
+func ClusterGroupMembersWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, data, []string{"Id", "Name", "Cloud", "Distribution", "Status"})
+}
+
+// ClusterGroupDeploymentWrite writes a cluster group deployment to the output.
+func ClusterGroupDeploymentWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, []interface{}{data}, []string{"ReleaseName", "Chart", "ChartVersion", "Namespace", "Version", "Description", "CreatedAt", "UpdatedAt"})
+}
+
+// ClusterGroupDeploymentsWrite writes a cluster group deployment list to the output.
+func ClusterGroupDeploymentsWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, data, []string{"ReleaseName", "Chart", "ChartVersion", "Namespace", "Version", "UpdatedAt"})
+}
+
+// ClusterGroupDeploymentTargetsWrite writes the status of a deployment on the target clusters to the output.
+func ClusterGroupDeploymentTargetsWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, data, []string{"ClusterId", "ClusterName", "Cloud", "Distribution", "Status", "Version", "Stale", "Error"})
+}
+
+// ClusterGroupFeatureWrite writes a cluster group feature to the output.
+func ClusterGroupFeatureWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, []interface{}{data}, []string{"Name", "Enabled", "ReconcileState", "LastReconcileError"})
+}
+
+// ClusterGroupFeaturesWrite writes a cluster group feature list to the output.
+func ClusterGroupFeaturesWrite(context formatContext, data interface{}) {
+	clusterGroupsWrite(context, data, []string{"Name", "Enabled", "ReconcileState"})
+}
+
+func clusterGroupsWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	if err := output.Output(ctx, data); err != nil {
+		log.Fatal(err)
+	}
+}
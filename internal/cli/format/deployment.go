@@ -0,0 +1,53 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// DeploymentWrite writes a Helm deployment to the output.
+func DeploymentWrite(context formatContext, data interface{}) {
+	deploymentsWrite(context, []interface{}{data}, []string{"ReleaseName", "Chart", "ChartVersion", "Namespace", "Version", "Status", "CreatedAt", "UpdatedAt"})
+}
+
+// DeploymentsWrite writes a Helm deployment list to the output.
+func DeploymentsWrite(context formatContext, data interface{}) {
+	deploymentsWrite(context, data, []string{"ReleaseName", "Chart", "ChartVersion", "Namespace", "Status", "UpdatedAt"})
+}
+
+// DeploymentResourcesWrite writes the Kubernetes resources of a Helm deployment to the output.
+func DeploymentResourcesWrite(context formatContext, data interface{}) {
+	deploymentsWrite(context, data, []string{"Kind", "Name"})
+}
+
+// DeploymentImagesWrite writes the container images of a Helm deployment to the output.
+func DeploymentImagesWrite(context formatContext, data interface{}) {
+	deploymentsWrite(context, data, []string{"ImageName", "ImageTag", "ImageDigest"})
+}
+
+func deploymentsWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	if err := output.Output(ctx, data); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// ImagesWrite writes a container image list to the output.
+func ImagesWrite(context formatContext, data interface{}) {
+	imagesWrite(context, data, []string{"ImageName", "ImageTag", "ImageDigest"})
+}
+
+// ImageDeploymentsWrite writes a container image list with the deployments using each image to the output.
+func ImageDeploymentsWrite(context formatContext, data interface{}) {
+	imagesWrite(context, data, []string{"ImageName", "ImageTag", "ImageDigest", "Deployments"})
+}
+
+// ScanLogsWrite writes an image scan log to the output.
+func ScanLogsWrite(context formatContext, data interface{}) {
+	imagesWrite(context, data, []string{"ReleaseName", "Resource", "Images", "Action", "Severity", "Result"})
+}
+
+// WhitelistsWrite writes a list of releases exempted from security scan admission to the output.
+func WhitelistsWrite(context formatContext, data interface{}) {
+	imagesWrite(context, data, []string{"Name", "Owner", "Reason"})
+}
+
+func imagesWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	if err := output.Output(ctx, data); err != nil {
+		log.Fatal(err)
+	}
+}
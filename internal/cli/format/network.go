@@ -0,0 +1,48 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"github.com/banzaicloud/banzai-cli/internal/cli/output"
+	log "github.com/sirupsen/logrus"
+)
+
+// VPCNetworksWrite writes a VPC network list to the output.
+func VPCNetworksWrite(context formatContext, data interface{}) {
+	networksWrite(context, data, []string{"Id", "Name", "Cidrs"})
+}
+
+// SubnetsWrite writes a subnet list to the output.
+func SubnetsWrite(context formatContext, data interface{}) {
+	networksWrite(context, data, []string{"Id", "Name", "Cidrs", "Location"})
+}
+
+// RouteTablesWrite writes a route table list to the output.
+func RouteTablesWrite(context formatContext, data interface{}) {
+	networksWrite(context, data, []string{"Id", "Name"})
+}
+
+func networksWrite(context formatContext, data interface{}, fields []string) {
+	ctx := &output.Context{
+		Out:    context.Out(),
+		Color:  context.Color(),
+		Format: context.OutputFormat(),
+		Fields: fields,
+	}
+
+	if err := output.Output(ctx, data); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -40,3 +40,18 @@ func SecretsWrite(out io.Writer, format string, color bool, data interface{}) {
 		log.Fatal(err)
 	}
 }
+
+// SecretTagsWrite writes a secret tag list to the output.
+func SecretTagsWrite(out io.Writer, format string, color bool, data interface{}) {
+	ctx := &output.Context{
+		Out:    out,
+		Color:  color,
+		Format: format,
+		Fields: []string{"Tag"},
+	}
+
+	err := output.Output(ctx, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package input
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/antihax/optional"
+
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
+	"github.com/banzaicloud/banzai-cli/internal/cli"
+	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
+)
+
+// NetworkLocation identifies where the networks of a cloud provider are listed
+type NetworkLocation struct {
+	SecretID      string
+	Cloud         string
+	Region        string
+	ResourceGroup string
+}
+
+func (l NetworkLocation) vpcOpts() *pipeline.ListVPCNetworksOpts {
+	opts := pipeline.ListVPCNetworksOpts{}
+	if l.Region != "" {
+		opts.Region = optional.NewString(l.Region)
+	}
+	if l.ResourceGroup != "" {
+		opts.ResourceGroup = optional.NewString(l.ResourceGroup)
+	}
+	return &opts
+}
+
+// ListVPCNetworks lists the VPC networks available at the given location
+func ListVPCNetworks(banzaiCli cli.Cli, orgID int32, location NetworkLocation) ([]pipeline.VpcNetworkInfo, error) {
+	networks, _, err := banzaiCli.Client().NetworkApi.ListVPCNetworks(context.Background(), orgID, location.SecretID, location.Cloud, location.vpcOpts())
+	if err != nil {
+		cli.LogAPIError("list VPC networks", err, location)
+		return nil, errors.WrapIf(utils.ConvertError(err), "could not list VPC networks")
+	}
+
+	return networks, nil
+}
+
+// ListVPCSubnets lists the subnets of the given VPC network
+func ListVPCSubnets(banzaiCli cli.Cli, orgID int32, location NetworkLocation, networkID string) ([]pipeline.SubnetInfo, error) {
+	opts := location.vpcOpts()
+	subnets, _, err := banzaiCli.Client().NetworkApi.ListVPCSubnets(context.Background(), orgID, networkID, location.SecretID, location.Cloud, &pipeline.ListVPCSubnetsOpts{
+		Region:        opts.Region,
+		ResourceGroup: opts.ResourceGroup,
+	})
+	if err != nil {
+		cli.LogAPIError("list VPC subnets", err, networkID)
+		return nil, errors.WrapIf(utils.ConvertError(err), "could not list subnets")
+	}
+
+	return subnets, nil
+}
+
+// ListRouteTables lists the route tables of the given VPC network
+func ListRouteTables(banzaiCli cli.Cli, orgID int32, location NetworkLocation, networkID string) ([]pipeline.RouteTableInfo, error) {
+	opts := location.vpcOpts()
+	routeTables, _, err := banzaiCli.Client().NetworkApi.ListRouteTables(context.Background(), orgID, networkID, location.SecretID, location.Cloud, &pipeline.ListRouteTablesOpts{
+		Region:        opts.Region,
+		ResourceGroup: opts.ResourceGroup,
+	})
+	if err != nil {
+		cli.LogAPIError("list route tables", err, networkID)
+		return nil, errors.WrapIf(utils.ConvertError(err), "could not list route tables")
+	}
+
+	return routeTables, nil
+}
+
+// AskVPCNetwork asks for an existing VPC network available at the given location
+func AskVPCNetwork(banzaiCli cli.Cli, orgID int32, location NetworkLocation) (string, error) {
+	networks, err := ListVPCNetworks(banzaiCli, orgID, location)
+	if err != nil {
+		return "", err
+	}
+
+	if len(networks) == 0 {
+		return "", errors.New("there are no VPC networks available")
+	}
+
+	options := make([]string, len(networks))
+	for i, network := range networks {
+		options[i] = networkOption(network.Id, network.Name, network.Cidrs)
+	}
+
+	var index int
+	if err := survey.AskOne(&survey.Select{Message: "VPC network:", Options: options}, &index); err != nil {
+		return "", errors.WrapIf(err, "failed to select VPC network")
+	}
+
+	return networks[index].Id, nil
+}
+
+// AskVPCSubnets asks for one or more subnets of the given VPC network
+func AskVPCSubnets(banzaiCli cli.Cli, orgID int32, location NetworkLocation, networkID string) ([]pipeline.SubnetInfo, error) {
+	subnets, err := ListVPCSubnets(banzaiCli, orgID, location, networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subnets) == 0 {
+		return nil, errors.Errorf("there are no subnets in VPC network %s", networkID)
+	}
+
+	options := make([]string, len(subnets))
+	for i, subnet := range subnets {
+		options[i] = networkOption(subnet.Id, subnet.Name, subnet.Cidrs)
+		if subnet.Location != "" {
+			options[i] = fmt.Sprintf("%s [%s]", options[i], subnet.Location)
+		}
+	}
+
+	var indices []int
+	if err := survey.AskOne(&survey.MultiSelect{Message: "Subnets:", Options: options}, &indices, survey.WithValidator(survey.Required)); err != nil {
+		return nil, errors.WrapIf(err, "failed to select subnets")
+	}
+
+	selected := make([]pipeline.SubnetInfo, len(indices))
+	for i, index := range indices {
+		selected[i] = subnets[index]
+	}
+
+	return selected, nil
+}
+
+// AskRouteTable asks for a route table of the given VPC network
+func AskRouteTable(banzaiCli cli.Cli, orgID int32, location NetworkLocation, networkID string) (string, error) {
+	routeTables, err := ListRouteTables(banzaiCli, orgID, location, networkID)
+	if err != nil {
+		return "", err
+	}
+
+	if len(routeTables) == 0 {
+		return "", errors.Errorf("there are no route tables in VPC network %s", networkID)
+	}
+
+	options := make([]string, len(routeTables))
+	for i, routeTable := range routeTables {
+		options[i] = networkOption(routeTable.Id, routeTable.Name, nil)
+	}
+
+	var index int
+	if err := survey.AskOne(&survey.Select{Message: "Route table:", Options: options}, &index); err != nil {
+		return "", errors.WrapIf(err, "failed to select route table")
+	}
+
+	return routeTables[index].Id, nil
+}
+
+func networkOption(id, name string, cidrs []string) string {
+	option := id
+	if name != "" && name != id {
+		option = fmt.Sprintf("%s (%s)", option, name)
+	}
+	if len(cidrs) > 0 {
+		option = fmt.Sprintf("%s %s", option, strings.Join(cidrs, ", "))
+	}
+	return option
+}
@@ -20,6 +20,7 @@ import (
 	"emperror.dev/errors"
 	"github.com/AlecAivazis/survey/v2"
 
+	"github.com/banzaicloud/banzai-cli/.gen/pipeline"
 	"github.com/banzaicloud/banzai-cli/internal/cli"
 	"github.com/banzaicloud/banzai-cli/internal/cli/utils"
 )
@@ -41,6 +42,60 @@ func AskResourceGroup(banzaiCli cli.Cli, orgID int32, secretID, defaultResourceG
 	return resourceGroup, nil
 }
 
+const newResourceGroupOption = "(create new resource group)"
+
+// AskOrCreateResourceGroup asks for Azure resource group available with the given secret ID, or creates a new one in the given location
+func AskOrCreateResourceGroup(banzaiCli cli.Cli, orgID int32, secretID, location, defaultResourceGroup string) (string, error) {
+	var resourceGroup string
+
+	rgs, _, err := banzaiCli.Client().InfoApi.GetResourceGroups(context.Background(), orgID, secretID)
+	if err != nil {
+		return "", errors.WrapIf(utils.ConvertError(err), "can't list resource groups")
+	}
+
+	err = survey.AskOne(&survey.Select{Message: "Resource group:", Options: append(rgs, newResourceGroupOption), Default: defaultResourceGroup}, &resourceGroup)
+	if err != nil {
+		return "", errors.WrapIf(err, "no resource group selected")
+	}
+
+	if resourceGroup != newResourceGroupOption {
+		return resourceGroup, nil
+	}
+
+	if err := survey.AskOne(&survey.Input{Message: "Name of the new resource group:"}, &resourceGroup, survey.WithValidator(survey.Required)); err != nil {
+		return "", errors.WrapIf(err, "no resource group name given")
+	}
+
+	if location == "" {
+		if location, err = AskLocation(banzaiCli, CloudProviderAzure); err != nil {
+			return "", err
+		}
+	}
+
+	return CreateResourceGroup(banzaiCli, orgID, secretID, resourceGroup, location)
+}
+
+// CreateResourceGroup creates an Azure resource group with the given secret ID
+func CreateResourceGroup(banzaiCli cli.Cli, orgID int32, secretID, name, location string) (string, error) {
+	request := pipeline.CreateResourceGroup{
+		Name:     name,
+		Location: location,
+		SecretId: secretID,
+	}
+
+	created, _, err := banzaiCli.Client().InfoApi.CreateResourceGroup(context.Background(), orgID, request)
+	if err != nil {
+		cli.LogAPIError("create resource group", err, request)
+		return "", errors.WrapIf(utils.ConvertError(err), "could not create resource group")
+	}
+
+	if created.Name == "" {
+		created.Name = name
+	}
+
+	return created.Name, nil
+}
+
 // IsResourceGroupValid checks whether the given resource group name is valid
 func IsResourceGroupValid(banzaiCli cli.Cli, orgID int32, secretID string, resourceGroup string) error {
 	rgs, _, err := banzaiCli.Client().InfoApi.GetResourceGroups(context.Background(), orgID, secretID)
@@ -0,0 +1,59 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"emperror.dev/errors"
+	"github.com/ghodss/yaml"
+)
+
+// ReadValuesFiles reads and merges the given Helm values files (or stdin in case of "-").
+// Values of a later file override the ones of an earlier one, the same way Helm does.
+func ReadValuesFiles(filenames []string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	for _, filename := range filenames {
+		fname, raw, err := ReadFileOrStdin(filename)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to read values", "filename", fname)
+		}
+
+		var current map[string]interface{}
+		if err := yaml.Unmarshal(raw, &current); err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to parse values", "filename", fname)
+		}
+
+		values = MergeValues(values, current)
+	}
+
+	return values, nil
+}
+
+// MergeValues merges src into dst recursively and returns dst.
+// Values of src override the ones of dst, except for maps which are merged key by key.
+func MergeValues(dst, src map[string]interface{}) map[string]interface{} {
+	for key, value := range src {
+		if srcMap, ok := value.(map[string]interface{}); ok {
+			if dstMap, ok := dst[key].(map[string]interface{}); ok {
+				dst[key] = MergeValues(dstMap, srcMap)
+				continue
+			}
+		}
+
+		dst[key] = value
+	}
+
+	return dst
+}
@@ -0,0 +1,68 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst      map[string]interface{}
+		src      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "add new keys",
+			dst:      map[string]interface{}{"a": 1},
+			src:      map[string]interface{}{"b": 2},
+			expected: map[string]interface{}{"a": 1, "b": 2},
+		},
+		{
+			name:     "override scalar",
+			dst:      map[string]interface{}{"a": 1},
+			src:      map[string]interface{}{"a": 2},
+			expected: map[string]interface{}{"a": 2},
+		},
+		{
+			name: "merge nested maps",
+			dst: map[string]interface{}{
+				"image": map[string]interface{}{"repository": "nginx", "tag": "1.17"},
+			},
+			src: map[string]interface{}{
+				"image": map[string]interface{}{"tag": "1.19"},
+			},
+			expected: map[string]interface{}{
+				"image": map[string]interface{}{"repository": "nginx", "tag": "1.19"},
+			},
+		},
+		{
+			name:     "replace map with scalar",
+			dst:      map[string]interface{}{"image": map[string]interface{}{"tag": "1.17"}},
+			src:      map[string]interface{}{"image": "nginx:1.19"},
+			expected: map[string]interface{}{"image": "nginx:1.19"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, MergeValues(test.dst, test.src))
+		})
+	}
+}